@@ -0,0 +1,18 @@
+package opt
+
+// Cache is implemented by caches that return values using the comma-ok idiom. Most cache libraries
+// fit this interface, which lets you use them with [FromCache]. Its method is called Get (rather
+// than e.g. GetRaw) for this reason, since that is the name these libraries use.
+type Cache[K comparable, V any] interface {
+	Get(key K) (value V, ok bool)
+}
+
+// FromCache looks up the given key in the cache, and returns an option containing the cached value
+// on a hit, or an empty option on a miss.
+func FromCache[K comparable, V any](cache Cache[K, V], key K) Option[V] {
+	if value, ok := cache.Get(key); ok {
+		return Option[V]{hasValue: true, Value: value}
+	} else {
+		return Option[V]{hasValue: false}
+	}
+}
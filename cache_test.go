@@ -0,0 +1,30 @@
+package opt_test
+
+import (
+	"testing"
+
+	"hermannm.dev/opt"
+)
+
+type fakeCache map[string]int
+
+func (cache fakeCache) Get(key string) (int, bool) {
+	value, ok := cache[key]
+	return value, ok
+}
+
+func TestFromCacheHit(t *testing.T) {
+	cache := fakeCache{"key": 5}
+
+	if option := opt.FromCache(cache, "key"); !option.HasValue() || option.Value != 5 {
+		t.Errorf("FromCache() = %v; want 5", option)
+	}
+}
+
+func TestFromCacheMiss(t *testing.T) {
+	cache := fakeCache{"key": 5}
+
+	if option := opt.FromCache(cache, "other"); !option.IsEmpty() {
+		t.Errorf("FromCache() = %v; want <empty>", option)
+	}
+}
@@ -0,0 +1,109 @@
+package opt
+
+import (
+	"cmp"
+)
+
+// Contains returns true if the given option has a value that is equal to the given value.
+func Contains[T comparable](option Option[T], value T) bool {
+	return option.hasValue && option.Value == value
+}
+
+// Equal returns true if both options are empty, or both have values that are equal. Unlike
+// comparing options with ==, this ignores the value field of empty options.
+func Equal[T comparable](a Option[T], b Option[T]) bool {
+	if a.hasValue && b.hasValue {
+		return a.Value == b.Value
+	} else {
+		return a.hasValue == b.hasValue
+	}
+}
+
+// Compare compares two options, returning -1 if a is less than b, 0 if they are equal, and +1 if a
+// is greater than b. Empty options are considered less than all values (and equal to each other),
+// so they are sorted first. Values are compared with [cmp.Compare].
+//
+// Compare can be used with [slices.SortFunc]. To sort empty options last, use [CompareEmptyLast].
+func Compare[T cmp.Ordered](a Option[T], b Option[T]) int {
+	switch {
+	case a.hasValue && b.hasValue:
+		return cmp.Compare(a.Value, b.Value)
+	case a.hasValue:
+		return +1
+	case b.hasValue:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// CompareEmptyLast works like [Compare], except that empty options are considered greater than
+// all values, so they are sorted last.
+func CompareEmptyLast[T cmp.Ordered](a Option[T], b Option[T]) int {
+	switch {
+	case a.hasValue && b.hasValue:
+		return cmp.Compare(a.Value, b.Value)
+	case a.hasValue:
+		return -1
+	case b.hasValue:
+		return +1
+	default:
+		return 0
+	}
+}
+
+// Less returns true if a is less than b, using the same ordering as [Compare] (where empty options
+// are less than all values).
+func Less[T cmp.Ordered](a Option[T], b Option[T]) bool {
+	return Compare(a, b) < 0
+}
+
+// Min returns the option with the smaller value. Empty options are ignored, like the MIN aggregate
+// in SQL: if only one option has a value, that option is returned, and if both are empty, an
+// empty option is returned.
+func Min[T cmp.Ordered](a Option[T], b Option[T]) Option[T] {
+	switch {
+	case a.hasValue && b.hasValue:
+		return Option[T]{hasValue: true, Value: min(a.Value, b.Value)}
+	case a.hasValue:
+		return a
+	default:
+		return b.Normalized()
+	}
+}
+
+// Max returns the option with the greater value. Like [Min], empty options are ignored: if only
+// one option has a value, that option is returned, and if both are empty, an empty option is
+// returned.
+func Max[T cmp.Ordered](a Option[T], b Option[T]) Option[T] {
+	switch {
+	case a.hasValue && b.hasValue:
+		return Option[T]{hasValue: true, Value: max(a.Value, b.Value)}
+	case a.hasValue:
+		return a
+	default:
+		return b.Normalized()
+	}
+}
+
+// Clamp returns an option with the value of the given option clamped to the range [lower, upper].
+// If the option is empty, an empty option is returned.
+func Clamp[T cmp.Ordered](option Option[T], lower T, upper T) Option[T] {
+	if option.hasValue {
+		return Option[T]{hasValue: true, Value: min(max(option.Value, lower), upper)}
+	} else {
+		return Option[T]{hasValue: false}
+	}
+}
+
+// MinPresent returns an option containing the smallest value among the given options that have
+// values, ignoring empty options. If none of the options have values, an empty option is returned.
+func MinPresent[T cmp.Ordered](options []Option[T]) Option[T] {
+	result := Option[T]{hasValue: false}
+	for _, option := range options {
+		if option.hasValue && (!result.hasValue || option.Value < result.Value) {
+			result = option
+		}
+	}
+	return result
+}
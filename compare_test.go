@@ -0,0 +1,166 @@
+package opt_test
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"hermannm.dev/opt"
+)
+
+func TestContains(t *testing.T) {
+	if !opt.Contains(opt.Value(1), 1) {
+		t.Error("Contains(Value(1), 1) = false; want true")
+	}
+	if opt.Contains(opt.Value(1), 2) {
+		t.Error("Contains(Value(1), 2) = true; want false")
+	}
+	if opt.Contains(opt.Empty[int](), 0) {
+		t.Error("Contains(Empty(), 0) = true; want false")
+	}
+}
+
+func TestEqual(t *testing.T) {
+	if !opt.Equal(opt.Value(1), opt.Value(1)) {
+		t.Error("Equal(Value(1), Value(1)) = false; want true")
+	}
+	if opt.Equal(opt.Value(1), opt.Value(2)) {
+		t.Error("Equal(Value(1), Value(2)) = true; want false")
+	}
+	if opt.Equal(opt.Value(0), opt.Empty[int]()) {
+		t.Error("Equal(Value(0), Empty()) = true; want false")
+	}
+}
+
+func TestEqualEmptyWithStaleValue(t *testing.T) {
+	var stale opt.Option[int]
+	if err := json.Unmarshal([]byte("5"), &stale); err != nil {
+		t.Fatalf("json.Unmarshal error: %v", err)
+	}
+	if err := json.Unmarshal([]byte("null"), &stale); err != nil {
+		t.Fatalf("json.Unmarshal error: %v", err)
+	}
+
+	if !opt.Equal(stale, opt.Empty[int]()) {
+		t.Error("Equal(stale empty, Empty()) = false; want true")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	options := []opt.Option[int]{opt.Value(3), opt.Empty[int](), opt.Value(1), opt.Empty[int]()}
+	slices.SortFunc(options, opt.Compare)
+
+	expected := []opt.Option[int]{opt.Empty[int](), opt.Empty[int](), opt.Value(1), opt.Value(3)}
+	if !slices.Equal(options, expected) {
+		t.Errorf("sorted = %s; want %s", opt.FormatSlice(options), opt.FormatSlice(expected))
+	}
+}
+
+func TestCompareEmptyLast(t *testing.T) {
+	options := []opt.Option[int]{opt.Value(3), opt.Empty[int](), opt.Value(1), opt.Empty[int]()}
+	slices.SortFunc(options, opt.CompareEmptyLast)
+
+	expected := []opt.Option[int]{opt.Value(1), opt.Value(3), opt.Empty[int](), opt.Empty[int]()}
+	if !slices.Equal(options, expected) {
+		t.Errorf("sorted = %s; want %s", opt.FormatSlice(options), opt.FormatSlice(expected))
+	}
+}
+
+func TestLess(t *testing.T) {
+	if !opt.Less(opt.Value(1), opt.Value(2)) {
+		t.Error("Less(1, 2) = false; want true")
+	}
+	if opt.Less(opt.Value(2), opt.Value(1)) {
+		t.Error("Less(2, 1) = true; want false")
+	}
+	if !opt.Less(opt.Empty[int](), opt.Value(-100)) {
+		t.Error("Less(<empty>, -100) = false; want true")
+	}
+	if opt.Less(opt.Empty[int](), opt.Empty[int]()) {
+		t.Error("Less(<empty>, <empty>) = true; want false")
+	}
+}
+
+func TestMinAndMax(t *testing.T) {
+	if option := opt.Min(opt.Value(3), opt.Value(1)); !option.HasValue() || option.Value != 1 {
+		t.Errorf("Min(3, 1) = %v; want 1", option)
+	}
+	if option := opt.Max(opt.Value(3), opt.Value(1)); !option.HasValue() || option.Value != 3 {
+		t.Errorf("Max(3, 1) = %v; want 3", option)
+	}
+}
+
+func TestMinAndMaxOneEmpty(t *testing.T) {
+	if option := opt.Min(opt.Empty[int](), opt.Value(1)); !option.HasValue() || option.Value != 1 {
+		t.Errorf("Min(<empty>, 1) = %v; want 1", option)
+	}
+	if option := opt.Max(opt.Value(3), opt.Empty[int]()); !option.HasValue() || option.Value != 3 {
+		t.Errorf("Max(3, <empty>) = %v; want 3", option)
+	}
+}
+
+func TestMinAndMaxBothEmpty(t *testing.T) {
+	if option := opt.Min(opt.Empty[int](), opt.Empty[int]()); !option.IsEmpty() {
+		t.Errorf("Min(<empty>, <empty>) = %v; want <empty>", option)
+	}
+	if option := opt.Max(opt.Empty[int](), opt.Empty[int]()); !option.IsEmpty() {
+		t.Errorf("Max(<empty>, <empty>) = %v; want <empty>", option)
+	}
+}
+
+func TestClampEmpty(t *testing.T) {
+	option := opt.Clamp(opt.Empty[int](), 1, 10)
+
+	if !option.IsEmpty() {
+		t.Errorf("IsEmpty: want true (got value %d)", option.Value)
+	}
+}
+
+func TestClampBelowMin(t *testing.T) {
+	option := opt.Clamp(opt.Value(-5), 1, 10)
+
+	if !option.HasValue() || option.Value != 1 {
+		t.Errorf("Clamp() = %v; want 1", option)
+	}
+}
+
+func TestClampWithinRange(t *testing.T) {
+	option := opt.Clamp(opt.Value(5), 1, 10)
+
+	if !option.HasValue() || option.Value != 5 {
+		t.Errorf("Clamp() = %v; want 5", option)
+	}
+}
+
+func TestClampAboveMax(t *testing.T) {
+	option := opt.Clamp(opt.Value(50), 1, 10)
+
+	if !option.HasValue() || option.Value != 10 {
+		t.Errorf("Clamp() = %v; want 10", option)
+	}
+}
+
+func TestMinPresentAllEmpty(t *testing.T) {
+	option := opt.MinPresent([]opt.Option[int]{opt.Empty[int](), opt.Empty[int]()})
+
+	if !option.IsEmpty() {
+		t.Errorf("MinPresent() = %v; want <empty>", option)
+	}
+}
+
+func TestMinPresentSingle(t *testing.T) {
+	option := opt.MinPresent([]opt.Option[int]{opt.Empty[int](), opt.Value(5)})
+
+	if !option.HasValue() || option.Value != 5 {
+		t.Errorf("MinPresent() = %v; want 5", option)
+	}
+}
+
+func TestMinPresentMultiple(t *testing.T) {
+	options := []opt.Option[int]{opt.Value(5), opt.Empty[int](), opt.Value(-2), opt.Value(3)}
+	option := opt.MinPresent(options)
+
+	if !option.HasValue() || option.Value != -2 {
+		t.Errorf("MinPresent() = %v; want -2", option)
+	}
+}
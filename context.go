@@ -0,0 +1,15 @@
+package opt
+
+import (
+	"context"
+)
+
+// FromCause returns an option containing the cause of the given context's cancellation, as
+// returned by [context.Cause]. If the context has not been canceled, an empty option is returned.
+func FromCause(ctx context.Context) Option[error] {
+	if cause := context.Cause(ctx); cause != nil {
+		return Option[error]{hasValue: true, Value: cause}
+	} else {
+		return Option[error]{hasValue: false}
+	}
+}
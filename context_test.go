@@ -0,0 +1,48 @@
+package opt_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"hermannm.dev/opt"
+)
+
+func TestFromCauseLiveContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	option := opt.FromCause(ctx)
+	if !option.IsEmpty() {
+		t.Errorf("IsEmpty: want true (got value %v)", option.Value)
+	}
+}
+
+func TestFromCauseCanceledContext(t *testing.T) {
+	cause := errors.New("shutting down")
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(cause)
+
+	option := opt.FromCause(ctx)
+	if !option.HasValue() {
+		t.Fatal("HasValue: want true")
+	}
+	if option.Value != cause {
+		t.Errorf("Value = %v; want %v", option.Value, cause)
+	}
+}
+
+func TestFromCauseDeadlineExceeded(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Nanosecond)
+	defer cancel()
+	<-ctx.Done()
+
+	option := opt.FromCause(ctx)
+	if !option.HasValue() {
+		t.Fatal("HasValue: want true")
+	}
+	if !errors.Is(option.Value, context.DeadlineExceeded) {
+		t.Errorf("Value = %v; want %v", option.Value, context.DeadlineExceeded)
+	}
+}
@@ -0,0 +1,113 @@
+package opt
+
+import (
+	"errors"
+	"fmt"
+)
+
+// EmptyFieldError is returned by [Option.ErrIfEmpty] when the option is empty. It carries the name
+// of the field that was missing, which you can get with [EmptyFieldError.Field] (e.g. for
+// aggregating validation errors).
+type EmptyFieldError struct {
+	field string
+}
+
+// Error implements the error interface for [EmptyFieldError].
+func (err EmptyFieldError) Error() string {
+	return "missing value for required field '" + err.field + "'"
+}
+
+// Field returns the name of the field that was missing a value.
+func (err EmptyFieldError) Field() string {
+	return err.field
+}
+
+// ErrIfEmpty returns an [EmptyFieldError] with the given field name if the option is empty, or nil
+// if the option has a value.
+func (option Option[T]) ErrIfEmpty(field string) error {
+	if option.hasValue {
+		return nil
+	} else {
+		return EmptyFieldError{field: field}
+	}
+}
+
+// OkOr returns the option's value and a nil error if the option has a value. If the option is
+// empty, it returns the zero value and the given error. This is useful for returning an empty
+// option as an error at API boundaries:
+//
+//	user, err := findUser(id).OkOr(ErrUserNotFound)
+func (option Option[T]) OkOr(err error) (T, error) {
+	if option.hasValue {
+		return option.Value, nil
+	} else {
+		var zero T
+		return zero, err
+	}
+}
+
+// OkOrElse works like [Option.OkOr], but only calls the given function to create the error if the
+// option is empty.
+func (option Option[T]) OkOrElse(errFn func() error) (T, error) {
+	if option.hasValue {
+		return option.Value, nil
+	} else {
+		var zero T
+		return zero, errFn()
+	}
+}
+
+// OrErrorf returns the option's value and a nil error if the option has a value. If the option is
+// empty, it returns the zero value and an error created by calling [fmt.Errorf] with the given
+// format and arguments.
+func (option Option[T]) OrErrorf(format string, args ...any) (T, error) {
+	if option.hasValue {
+		return option.Value, nil
+	} else {
+		var zero T
+		return zero, fmt.Errorf(format, args...)
+	}
+}
+
+// CollectErrors returns an option containing the given errors combined with [errors.Join], or an
+// empty option if all the errors are nil. This pairs well with [Option.ErrIfEmpty], to validate
+// several required fields at once:
+//
+//	errs := opt.CollectErrors(name.ErrIfEmpty("name"), age.ErrIfEmpty("age"))
+//	if err, failed := errs.Get(); failed {
+//		return err
+//	}
+func CollectErrors(errs ...error) Option[error] {
+	if err := errors.Join(errs...); err != nil {
+		return Option[error]{hasValue: true, Value: err}
+	} else {
+		return Option[error]{hasValue: false}
+	}
+}
+
+// ValidateWith runs each of the given rules against the option's value, and returns the non-nil
+// errors that they return. If the option is empty, there is nothing to validate, so no rules are
+// run and nil is returned. To also require a value, combine this with [Option.ErrIfEmpty].
+func (option Option[T]) ValidateWith(rules ...func(value T) error) []error {
+	if !option.hasValue {
+		return nil
+	}
+
+	var errs []error
+	for _, rule := range rules {
+		if err := rule(option.Value); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// Squash combines an option and an error, as returned by functions with the signature
+// `func() (Option[T], error)`, into a single option. If err is non-nil, an empty option is
+// returned (regardless of the given option). Otherwise, the option is returned.
+func Squash[T any](option Option[T], err error) Option[T] {
+	if err != nil {
+		return Option[T]{hasValue: false}
+	}
+	return option.Normalized()
+}
@@ -0,0 +1,190 @@
+package opt_test
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"hermannm.dev/opt"
+)
+
+func TestErrIfEmptyValue(t *testing.T) {
+	if err := opt.Value("test").ErrIfEmpty("name"); err != nil {
+		t.Errorf("ErrIfEmpty() = %v; want nil", err)
+	}
+}
+
+func TestErrIfEmptyEmpty(t *testing.T) {
+	err := opt.Empty[string]().ErrIfEmpty("name")
+
+	var fieldErr opt.EmptyFieldError
+	if !errors.As(err, &fieldErr) {
+		t.Fatalf("ErrIfEmpty() = %v; want EmptyFieldError", err)
+	}
+	if fieldErr.Field() != "name" {
+		t.Errorf("Field() = %s; want 'name'", fieldErr.Field())
+	}
+
+	expected := "missing value for required field 'name'"
+	if err.Error() != expected {
+		t.Errorf("Error() = %s; want %s", err.Error(), expected)
+	}
+}
+
+func TestOkOr(t *testing.T) {
+	value, err := opt.Value("test").OkOr(errEmpty)
+	if value != "test" || err != nil {
+		t.Errorf("OkOr() = %s, %v; want 'test', nil", value, err)
+	}
+
+	value, err = opt.Empty[string]().OkOr(errEmpty)
+	if value != "" || err != errEmpty {
+		t.Errorf("OkOr() = %s, %v; want '', %v", value, err, errEmpty)
+	}
+}
+
+func TestOkOrElse(t *testing.T) {
+	value, err := opt.Value("test").OkOrElse(func() error {
+		t.Error("error function called on option with value")
+		return errEmpty
+	})
+	if value != "test" || err != nil {
+		t.Errorf("OkOrElse() = %s, %v; want 'test', nil", value, err)
+	}
+
+	value, err = opt.Empty[string]().OkOrElse(func() error { return errEmpty })
+	if value != "" || err != errEmpty {
+		t.Errorf("OkOrElse() = %s, %v; want '', %v", value, err, errEmpty)
+	}
+}
+
+func TestOrErrorfValue(t *testing.T) {
+	value, err := opt.Value("test").OrErrorf("user %d has no name", 1)
+
+	if err != nil {
+		t.Errorf("OrErrorf() error = %v; want nil", err)
+	}
+	if value != "test" {
+		t.Errorf("OrErrorf() value = %s; want 'test'", value)
+	}
+}
+
+func TestOrErrorfEmpty(t *testing.T) {
+	value, err := opt.Empty[string]().OrErrorf("user %d has no %s", 1, "name")
+
+	if err == nil {
+		t.Fatal("OrErrorf() error = nil; want error")
+	}
+	expected := "user 1 has no name"
+	if err.Error() != expected {
+		t.Errorf("OrErrorf() error = %s; want %s", err.Error(), expected)
+	}
+	if value != "" {
+		t.Errorf("OrErrorf() value = %s; want zero value ''", value)
+	}
+}
+
+func TestCollectErrorsAllNil(t *testing.T) {
+	option := opt.CollectErrors(nil, nil)
+
+	if !option.IsEmpty() {
+		t.Errorf("CollectErrors() = %v; want <empty>", option)
+	}
+}
+
+func TestCollectErrorsSomeSet(t *testing.T) {
+	err1 := errors.New("error 1")
+	option := opt.CollectErrors(nil, err1, nil)
+
+	if !option.HasValue() {
+		t.Fatal("HasValue: want true")
+	}
+	if !errors.Is(option.Value, err1) {
+		t.Errorf("Value = %v; want to wrap %v", option.Value, err1)
+	}
+}
+
+func TestCollectErrorsAllSet(t *testing.T) {
+	err1 := errors.New("error 1")
+	err2 := errors.New("error 2")
+	option := opt.CollectErrors(err1, err2)
+
+	if !option.HasValue() {
+		t.Fatal("HasValue: want true")
+	}
+	if !errors.Is(option.Value, err1) || !errors.Is(option.Value, err2) {
+		t.Errorf("Value = %v; want to wrap both %v and %v", option.Value, err1, err2)
+	}
+
+	expected := "error 1\nerror 2"
+	if option.Value.Error() != expected {
+		t.Errorf("Value.Error() = %q; want %q", option.Value.Error(), expected)
+	}
+}
+
+var errTooShort = errors.New("too short")
+var errNoDigits = errors.New("no digits")
+
+func minLength(value string) error {
+	if len(value) < 8 {
+		return errTooShort
+	}
+	return nil
+}
+
+func hasDigit(value string) error {
+	if !strings.ContainsAny(value, "0123456789") {
+		return errNoDigits
+	}
+	return nil
+}
+
+func TestValidateWithEmpty(t *testing.T) {
+	errs := opt.Empty[string]().ValidateWith(minLength, hasDigit)
+
+	if len(errs) != 0 {
+		t.Errorf("ValidateWith() = %v; want no errors", errs)
+	}
+}
+
+func TestValidateWithAllPass(t *testing.T) {
+	errs := opt.Value("password1").ValidateWith(minLength, hasDigit)
+
+	if len(errs) != 0 {
+		t.Errorf("ValidateWith() = %v; want no errors", errs)
+	}
+}
+
+func TestValidateWithSomeFail(t *testing.T) {
+	errs := opt.Value("pass").ValidateWith(minLength, hasDigit)
+
+	expected := []error{errTooShort, errNoDigits}
+	if !slices.Equal(errs, expected) {
+		t.Errorf("ValidateWith() = %v; want %v", errs, expected)
+	}
+}
+
+func TestSquashError(t *testing.T) {
+	option := opt.Squash(opt.Value("test"), errEmpty)
+
+	if !option.IsEmpty() {
+		t.Errorf("Squash() = %v; want <empty>", option)
+	}
+}
+
+func TestSquashNoErrorValue(t *testing.T) {
+	option := opt.Squash(opt.Value("test"), nil)
+
+	if !option.HasValue() || option.Value != "test" {
+		t.Errorf("Squash() = %v; want 'test'", option)
+	}
+}
+
+func TestSquashNoErrorEmpty(t *testing.T) {
+	option := opt.Squash(opt.Empty[string](), nil)
+
+	if !option.IsEmpty() {
+		t.Errorf("Squash() = %v; want <empty>", option)
+	}
+}
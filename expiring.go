@@ -0,0 +1,51 @@
+package opt
+
+import (
+	"time"
+)
+
+// Expiring is an optional value that expires after a given duration. [Expiring.Get] returns the
+// value only if less than TTL has passed since it was last set with [Expiring.Set]. An Expiring
+// that has never been set is empty.
+//
+// You construct an Expiring with a struct literal, e.g. `opt.Expiring[string]{TTL: time.Minute}`.
+//
+// Expiring is not safe for concurrent use. To share it between goroutines, guard it with a mutex.
+type Expiring[T any] struct {
+	// TTL is how long a value is kept after being set.
+	TTL time.Duration
+	// Now returns the current time, and is used to check expiry. If nil, [time.Now] is used. This
+	// can be set to a fake clock in tests.
+	Now func() time.Time
+
+	option Option[T]
+	setAt  time.Time
+}
+
+// Get returns an option containing the value if it was set less than TTL ago, or an empty option
+// if it has expired or was never set.
+func (expiring *Expiring[T]) Get() Option[T] {
+	if !expiring.option.hasValue {
+		return Option[T]{hasValue: false}
+	}
+
+	if expiring.now().Sub(expiring.setAt) >= expiring.TTL {
+		return Option[T]{hasValue: false}
+	}
+
+	return expiring.option
+}
+
+// Set stores the given value, and resets the expiry timer.
+func (expiring *Expiring[T]) Set(value T) {
+	expiring.option = Option[T]{hasValue: true, Value: value}
+	expiring.setAt = expiring.now()
+}
+
+func (expiring *Expiring[T]) now() time.Time {
+	if expiring.Now != nil {
+		return expiring.Now()
+	} else {
+		return time.Now()
+	}
+}
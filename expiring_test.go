@@ -0,0 +1,54 @@
+package opt_test
+
+import (
+	"testing"
+	"time"
+
+	"hermannm.dev/opt"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (clock *fakeClock) Now() time.Time {
+	return clock.now
+}
+
+func TestExpiringWithinTTL(t *testing.T) {
+	clock := fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	expiring := opt.Expiring[string]{TTL: time.Minute, Now: clock.Now}
+
+	expiring.Set("test")
+	clock.now = clock.now.Add(30 * time.Second)
+
+	if option := expiring.Get(); !option.HasValue() || option.Value != "test" {
+		t.Errorf("Get() = %v; want 'test'", option)
+	}
+}
+
+func TestExpiringExpired(t *testing.T) {
+	clock := fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	expiring := opt.Expiring[string]{TTL: time.Minute, Now: clock.Now}
+
+	expiring.Set("test")
+	clock.now = clock.now.Add(2 * time.Minute)
+
+	if option := expiring.Get(); !option.IsEmpty() {
+		t.Errorf("Get() = %v; want <empty>", option)
+	}
+
+	expiring.Set("refreshed")
+
+	if option := expiring.Get(); !option.HasValue() || option.Value != "refreshed" {
+		t.Errorf("Get() after Set = %v; want 'refreshed'", option)
+	}
+}
+
+func TestExpiringNeverSet(t *testing.T) {
+	expiring := opt.Expiring[string]{TTL: time.Minute}
+
+	if option := expiring.Get(); !option.IsEmpty() {
+		t.Errorf("Get() = %v; want <empty>", option)
+	}
+}
@@ -0,0 +1,56 @@
+package opt
+
+import "reflect"
+
+// Flag is an [Option] that implements [flag.Value], to let options back command-line flags where
+// "not provided" is meaningful. A flag that is not passed on the command line stays empty, and a
+// flag that is passed gets the value parsed from its argument.
+//
+// You construct a Flag with [NewFlag], and register it with [flag.Var]:
+//
+//	port := opt.NewFlag(strconv.Atoi)
+//	flag.Var(port, "port", "Port to listen on")
+//	flag.Parse()
+//	if port.HasValue() {
+//		// ...
+//	}
+//
+// A Flag of a boolean type can be passed without an argument (e.g. `-verbose`), like the flags
+// from [flag.Bool]. The parse function is then called with "true", so [strconv.ParseBool] works.
+type Flag[T any] struct {
+	Option[T]
+	parse func(value string) (T, error)
+}
+
+// NewFlag creates an empty [Flag], which uses the given function to parse command-line arguments.
+func NewFlag[T any](parse func(value string) (T, error)) *Flag[T] {
+	return &Flag[T]{parse: parse}
+}
+
+// Set implements [flag.Value] for [Flag]. It parses the given command-line argument, and stores
+// the result in the option. If parsing fails, the error is returned, and the option is unchanged.
+func (flag *Flag[T]) Set(value string) error {
+	parsed, err := flag.parse(value)
+	if err != nil {
+		return err
+	}
+
+	flag.Put(parsed)
+	return nil
+}
+
+// String implements [flag.Value] for [Flag]. It returns the string representation of the flag's
+// value, or an empty string if the flag is empty (so that empty flags show no default value in
+// usage messages).
+func (flag *Flag[T]) String() string {
+	if flag == nil || !flag.hasValue {
+		return ""
+	}
+	return flag.Option.String()
+}
+
+// IsBoolFlag is called by the [flag] package to check if the flag can be passed without an
+// argument. It returns true if T is a boolean type.
+func (flag *Flag[T]) IsBoolFlag() bool {
+	return reflect.TypeFor[T]().Kind() == reflect.Bool
+}
@@ -0,0 +1,96 @@
+package opt_test
+
+import (
+	"flag"
+	"io"
+	"strconv"
+	"testing"
+
+	"hermannm.dev/opt"
+)
+
+func parseFlags(t *testing.T, args ...string) (port *opt.Flag[int], host *opt.Flag[string]) {
+	t.Helper()
+
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+
+	port = opt.NewFlag(strconv.Atoi)
+	flags.Var(port, "port", "Port to listen on")
+	host = opt.NewFlag(func(value string) (string, error) { return value, nil })
+	flags.Var(host, "host", "Host to listen on")
+
+	if err := flags.Parse(args); err != nil {
+		t.Fatalf("FlagSet.Parse error: %v", err)
+	}
+	return port, host
+}
+
+func TestFlagUnset(t *testing.T) {
+	port, host := parseFlags(t)
+
+	if !port.IsEmpty() {
+		t.Errorf("port = %v; want <empty>", port.Option)
+	}
+	if !host.IsEmpty() {
+		t.Errorf("host = %v; want <empty>", host.Option)
+	}
+	if port.String() != "" {
+		t.Errorf("port.String() = %s; want ''", port.String())
+	}
+}
+
+func TestFlagProvided(t *testing.T) {
+	port, host := parseFlags(t, "-port", "8080")
+
+	if !port.HasValue() || port.Value != 8080 {
+		t.Errorf("port = %v; want 8080", port.Option)
+	}
+	if port.String() != "8080" {
+		t.Errorf("port.String() = %s; want '8080'", port.String())
+	}
+	if !host.IsEmpty() {
+		t.Errorf("host = %v; want <empty>", host.Option)
+	}
+}
+
+func TestFlagInvalid(t *testing.T) {
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+
+	port := opt.NewFlag(strconv.Atoi)
+	flags.Var(port, "port", "Port to listen on")
+
+	if err := flags.Parse([]string{"-port", "abc"}); err == nil {
+		t.Error("FlagSet.Parse error = nil; want error")
+	}
+	if !port.IsEmpty() {
+		t.Errorf("port = %v; want <empty>", port.Option)
+	}
+}
+
+func TestFlagBoolWithoutArgument(t *testing.T) {
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+
+	verbose := opt.NewFlag(strconv.ParseBool)
+	flags.Var(verbose, "verbose", "Enable verbose output")
+	port := opt.NewFlag(strconv.Atoi)
+	flags.Var(port, "port", "Port to listen on")
+
+	if err := flags.Parse([]string{"-verbose", "-port", "8080"}); err != nil {
+		t.Fatalf("FlagSet.Parse error: %v", err)
+	}
+	if !verbose.HasValue() || !verbose.Value {
+		t.Errorf("verbose = %v; want true", verbose.Option)
+	}
+	if !port.HasValue() || port.Value != 8080 {
+		t.Errorf("port = %v; want 8080", port.Option)
+	}
+}
+
+func TestFlagNonBoolRequiresArgument(t *testing.T) {
+	if port := opt.NewFlag(strconv.Atoi); port.IsBoolFlag() {
+		t.Error("IsBoolFlag() = true; want false for Flag[int]")
+	}
+}
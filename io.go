@@ -0,0 +1,34 @@
+package opt
+
+import (
+	"bufio"
+)
+
+// ScanNext advances the given scanner to the next token, and returns an option containing the
+// token's text. If the scanner stops (either at the end of the input or because of an error), an
+// empty option is returned. As with [bufio.Scanner.Scan], you should call [bufio.Scanner.Err]
+// after getting an empty option to distinguish between the two:
+//
+//	for token := opt.ScanNext(scanner); token.HasValue(); token = opt.ScanNext(scanner) {
+//		// ...
+//	}
+//	if err := scanner.Err(); err != nil {
+//		// ...
+//	}
+func ScanNext(scanner *bufio.Scanner) Option[string] {
+	if scanner.Scan() {
+		return Option[string]{hasValue: true, Value: scanner.Text()}
+	} else {
+		return Option[string]{hasValue: false}
+	}
+}
+
+// OptionFieldCSV returns the option's value for use as a CSV field, or the given placeholder (such
+// as "N/A" or "") if the option is empty.
+func OptionFieldCSV(option Option[string], emptyAs string) string {
+	if option.hasValue {
+		return option.Value
+	} else {
+		return emptyAs
+	}
+}
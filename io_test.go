@@ -0,0 +1,57 @@
+package opt_test
+
+import (
+	"bufio"
+	"encoding/csv"
+	"slices"
+	"strings"
+	"testing"
+
+	"hermannm.dev/opt"
+)
+
+func TestScanNext(t *testing.T) {
+	scanner := bufio.NewScanner(strings.NewReader("first\nsecond\nthird\n"))
+
+	var tokens []string
+	for token := opt.ScanNext(scanner); token.HasValue(); token = opt.ScanNext(scanner) {
+		tokens = append(tokens, token.Value)
+	}
+
+	expected := []string{"first", "second", "third"}
+	if !slices.Equal(tokens, expected) {
+		t.Errorf("tokens = %v; want %v", tokens, expected)
+	}
+	if err := scanner.Err(); err != nil {
+		t.Errorf("scanner.Err() = %v; want nil", err)
+	}
+}
+
+func TestScanNextEOF(t *testing.T) {
+	scanner := bufio.NewScanner(strings.NewReader(""))
+	option := opt.ScanNext(scanner)
+
+	if !option.IsEmpty() {
+		t.Errorf("IsEmpty: want true (got value %s)", option.Value)
+	}
+}
+
+func TestOptionFieldCSV(t *testing.T) {
+	var output strings.Builder
+	writer := csv.NewWriter(&output)
+
+	err := writer.Write([]string{
+		opt.OptionFieldCSV(opt.Value("hermannm"), "N/A"),
+		opt.OptionFieldCSV(opt.Empty[string](), "N/A"),
+		opt.OptionFieldCSV(opt.Empty[string](), ""),
+	})
+	if err != nil {
+		t.Fatalf("csv.Writer.Write error: %v", err)
+	}
+	writer.Flush()
+
+	expected := "hermannm,N/A,\n"
+	if output.String() != expected {
+		t.Errorf("CSV output = %q; want %q", output.String(), expected)
+	}
+}
@@ -0,0 +1,69 @@
+package opt
+
+import (
+	"iter"
+)
+
+// Values returns an iterator that yields the option's value if it has one, or nothing if it is
+// empty. This lets options be used in range-over-func loops and iterator pipelines.
+func (option Option[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if option.hasValue {
+			yield(option.Value)
+		}
+	}
+}
+
+// FromSeq returns an option containing the first element of the given sequence, or an empty
+// option if the sequence is empty. It stops the iteration after the first element.
+func FromSeq[T any](seq iter.Seq[T]) Option[T] {
+	for value := range seq {
+		return Option[T]{hasValue: true, Value: value}
+	}
+	return Option[T]{hasValue: false}
+}
+
+// FlatMapSeq returns an iterator that, for each option in the given sequence that has a value,
+// calls fn with the value and yields every element of the sequence it returns. Empty options are
+// skipped.
+func FlatMapSeq[T any, U any](seq iter.Seq[Option[T]], fn func(value T) iter.Seq[U]) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for option := range seq {
+			if !option.hasValue {
+				continue
+			}
+
+			for element := range fn(option.Value) {
+				if !yield(element) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// PresenceStats consumes the given sequence, and returns the number of options in it that have
+// values and the number that are empty.
+func PresenceStats[T any](seq iter.Seq[Option[T]]) (present int, empty int) {
+	for option := range seq {
+		if option.hasValue {
+			present++
+		} else {
+			empty++
+		}
+	}
+	return present, empty
+}
+
+// CompactSeq returns an iterator over the values of the options in the given sequence that have
+// values, skipping empty options. To use it with a slice of options, pass the slice through
+// [slices.Values]. See [Compact] for a version that returns a slice.
+func CompactSeq[T any](seq iter.Seq[Option[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for option := range seq {
+			if option.hasValue && !yield(option.Value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package opt_test
+
+import (
+	"iter"
+	"slices"
+	"testing"
+
+	"hermannm.dev/opt"
+)
+
+func TestValues(t *testing.T) {
+	if values := slices.Collect(opt.Value(1).Values()); !slices.Equal(values, []int{1}) {
+		t.Errorf("Values() = %v; want [1]", values)
+	}
+	if values := slices.Collect(opt.Empty[int]().Values()); len(values) != 0 {
+		t.Errorf("Values() = %v; want []", values)
+	}
+}
+
+func TestFromSeq(t *testing.T) {
+	yielded := 0
+	seq := func(yield func(int) bool) {
+		for i := 1; i <= 3; i++ {
+			yielded++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	if option := opt.FromSeq(seq); !option.HasValue() || option.Value != 1 {
+		t.Errorf("FromSeq() = %v; want 1", option)
+	}
+	if yielded != 1 {
+		t.Errorf("sequence yielded %d elements; want 1", yielded)
+	}
+}
+
+func TestFromSeqEmpty(t *testing.T) {
+	if option := opt.FromSeq(slices.Values([]int{})); !option.IsEmpty() {
+		t.Errorf("FromSeq() = %v; want <empty>", option)
+	}
+}
+
+func repeat(value int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for range value {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+func TestFlatMapSeq(t *testing.T) {
+	options := []opt.Option[int]{opt.Value(1), opt.Empty[int](), opt.Value(2), opt.Value(3)}
+	values := slices.Collect(opt.FlatMapSeq(slices.Values(options), repeat))
+
+	expected := []int{1, 2, 2, 3, 3, 3}
+	if !slices.Equal(values, expected) {
+		t.Errorf("FlatMapSeq() = %v; want %v", values, expected)
+	}
+}
+
+func TestFlatMapSeqBreak(t *testing.T) {
+	options := []opt.Option[int]{opt.Value(2), opt.Value(3)}
+
+	var values []int
+	for value := range opt.FlatMapSeq(slices.Values(options), repeat) {
+		values = append(values, value)
+		if len(values) == 3 {
+			break
+		}
+	}
+
+	expected := []int{2, 2, 3}
+	if !slices.Equal(values, expected) {
+		t.Errorf("FlatMapSeq() = %v; want %v", values, expected)
+	}
+}
+
+func TestPresenceStats(t *testing.T) {
+	options := []opt.Option[int]{
+		opt.Value(1),
+		opt.Empty[int](),
+		opt.Value(3),
+		opt.Empty[int](),
+		opt.Empty[int](),
+	}
+
+	consumed := 0
+	seq := func(yield func(opt.Option[int]) bool) {
+		for _, option := range options {
+			consumed++
+			if !yield(option) {
+				return
+			}
+		}
+	}
+
+	present, empty := opt.PresenceStats(seq)
+	if present != 2 || empty != 3 {
+		t.Errorf("PresenceStats() = %d, %d; want 2, 3", present, empty)
+	}
+	if consumed != len(options) {
+		t.Errorf("consumed %d elements; want %d", consumed, len(options))
+	}
+}
+
+func TestCompactSeq(t *testing.T) {
+	options := []opt.Option[int]{opt.Empty[int](), opt.Value(1), opt.Empty[int](), opt.Value(3)}
+	values := slices.Collect(opt.CompactSeq(slices.Values(options)))
+
+	expected := []int{1, 3}
+	if !slices.Equal(values, expected) {
+		t.Errorf("CompactSeq() = %v; want %v", values, expected)
+	}
+}
+
+func TestCompactSeqBreak(t *testing.T) {
+	options := []opt.Option[int]{opt.Value(1), opt.Empty[int](), opt.Value(2), opt.Value(3)}
+
+	var values []int
+	for value := range opt.CompactSeq(slices.Values(options)) {
+		values = append(values, value)
+		if value == 2 {
+			break
+		}
+	}
+
+	expected := []int{1, 2}
+	if !slices.Equal(values, expected) {
+		t.Errorf("CompactSeq() = %v; want %v", values, expected)
+	}
+}
@@ -0,0 +1,159 @@
+package opt
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+)
+
+// FromJSONPath walks the given path of keys through a decoded JSON document (as produced by
+// unmarshaling into an `any`), and returns an option containing the value at the end of the path.
+// If a key along the path is missing, or an intermediate value is not a JSON object
+// (map[string]any), an empty option is returned.
+//
+// With no path, the document itself is returned.
+func FromJSONPath(doc any, path ...string) Option[any] {
+	current := doc
+	for _, key := range path {
+		object, ok := current.(map[string]any)
+		if !ok {
+			return Option[any]{hasValue: false}
+		}
+
+		current, ok = object[key]
+		if !ok {
+			return Option[any]{hasValue: false}
+		}
+	}
+
+	return Option[any]{hasValue: true, Value: current}
+}
+
+// MarshalCompact marshals the given map of options to a JSON object, omitting keys whose options
+// are empty (instead of marshaling them to `null`, as [json.Marshal] would). Keys are sorted, as
+// for any map passed to [json.Marshal].
+func MarshalCompact[T any](options map[string]Option[T]) ([]byte, error) {
+	values := make(map[string]T, len(options))
+	for key, option := range options {
+		if option.hasValue {
+			values[key] = option.Value
+		}
+	}
+
+	return json.Marshal(values)
+}
+
+// JSONEqual returns true if the given options marshal to equivalent JSON. Two empty options are
+// equal (both marshal to `null`). The marshaled values are canonicalized before comparing, so
+// differences in object key order or whitespace (e.g. from a custom [json.Marshaler]) are ignored.
+//
+// If either option fails to marshal, the error is returned.
+func JSONEqual[T any](a Option[T], b Option[T]) (bool, error) {
+	aJSON, err := canonicalJSON(a)
+	if err != nil {
+		return false, err
+	}
+
+	bJSON, err := canonicalJSON(b)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(aJSON, bJSON), nil
+}
+
+func canonicalJSON[T any](option Option[T]) ([]byte, error) {
+	jsonValue, err := option.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	// Decoding to any and re-encoding sorts object keys and strips whitespace. UseNumber preserves
+	// the exact representation of numbers.
+	decoder := json.NewDecoder(bytes.NewReader(jsonValue))
+	decoder.UseNumber()
+	var decoded any
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(decoded)
+}
+
+// OverlayJSON decodes the base JSON document into target, and then decodes the override document
+// on top of it. Since [Option] unmarshals `null` to an empty option, and fields that are absent
+// from the JSON are left untouched by [json.Unmarshal], this gives the following semantics for
+// option fields in target:
+//   - A field set to a value in override replaces the value from base. This also holds for map and
+//     struct values, which are not merged with the value from base (see [Option.UnmarshalJSON])
+//   - A field set to `null` in override clears the value from base
+//   - A field absent from override keeps the value from base
+//
+// target must be a pointer, as for [json.Unmarshal].
+func OverlayJSON(base []byte, override []byte, target any) error {
+	if err := json.Unmarshal(base, target); err != nil {
+		return fmt.Errorf("failed to decode base JSON: %w", err)
+	}
+	if err := json.Unmarshal(override, target); err != nil {
+		return fmt.Errorf("failed to decode override JSON: %w", err)
+	}
+	return nil
+}
+
+// MarshalSortedMap marshals the given map of options to a JSON object, with keys in sorted order.
+// If omitEmpty is true, keys whose options are empty are left out (like [MarshalCompact]).
+// Otherwise, empty options marshal to `null`.
+//
+// The output is deterministic, which makes it suitable for golden-file tests. This is already the
+// case for [json.Marshal], which sorts map keys, so this is a convenience for choosing between the
+// two behaviors for empty options.
+func MarshalSortedMap[T any](options map[string]Option[T], omitEmpty bool) ([]byte, error) {
+	if omitEmpty {
+		return MarshalCompact(options)
+	}
+	return json.Marshal(options)
+}
+
+// MarshalNDJSON writes the given options to w as newline-delimited JSON, with one value per line.
+// Empty options are written as `null` lines.
+func MarshalNDJSON[T any](options []Option[T], w io.Writer) error {
+	// json.Encoder writes a newline after each value
+	encoder := json.NewEncoder(w)
+	for i, option := range options {
+		if err := encoder.Encode(option); err != nil {
+			return fmt.Errorf("failed to write option at index %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// FromJSONNumber parses the given [json.Number], and returns an option containing either an
+// int64 (if the number has no fractional part and fits in int64) or a float64 (otherwise). Numbers
+// such as "1.0" and "1e3" are integral, so they give an int64. If the number cannot be parsed, an
+// empty option is returned.
+//
+// Numbers written with a fraction or exponent are parsed as float64 first, which can only represent
+// every integer up to 2^53. Such numbers give an int64 only if their magnitude is below 2^53, so
+// that "9007199254740993.0" gives a float64 rather than a silently rounded int64. Integer literals
+// (without a fraction or exponent) give an int64 across the whole int64 range.
+//
+// This preserves integer semantics for numbers decoded with [json.Decoder.UseNumber], which would
+// otherwise all become float64.
+func FromJSONNumber(number json.Number) Option[any] {
+	// Parse as an integer first, since large integers may lose precision as floats
+	if integer, err := number.Int64(); err == nil {
+		return Option[any]{hasValue: true, Value: integer}
+	}
+
+	float, err := number.Float64()
+	if err != nil {
+		return Option[any]{hasValue: false}
+	}
+	// Beyond 2^53, float64 cannot represent every integer, so the value may have been rounded
+	if float == math.Trunc(float) && math.Abs(float) < 1<<53 {
+		return Option[any]{hasValue: true, Value: int64(float)}
+	}
+	return Option[any]{hasValue: true, Value: float}
+}
@@ -0,0 +1,280 @@
+package opt_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"hermannm.dev/opt"
+)
+
+func decodeJSONDocument(t *testing.T, jsonValue string) any {
+	t.Helper()
+
+	var doc any
+	if err := json.Unmarshal([]byte(jsonValue), &doc); err != nil {
+		t.Fatalf("json.Unmarshal error: %v", err)
+	}
+	return doc
+}
+
+func TestFromJSONPathValid(t *testing.T) {
+	doc := decodeJSONDocument(t, `{"user":{"address":{"city":"Oslo"}}}`)
+	option := opt.FromJSONPath(doc, "user", "address", "city")
+
+	if !option.HasValue() {
+		t.Fatal("HasValue: want true")
+	}
+	if option.Value != "Oslo" {
+		t.Errorf("Value = %v; want 'Oslo'", option.Value)
+	}
+}
+
+func TestFromJSONPathMissingKey(t *testing.T) {
+	doc := decodeJSONDocument(t, `{"user":{"address":{"city":"Oslo"}}}`)
+	option := opt.FromJSONPath(doc, "user", "phone")
+
+	if !option.IsEmpty() {
+		t.Errorf("IsEmpty: want true (got value %v)", option.Value)
+	}
+}
+
+func TestFromJSONPathNonMapIntermediate(t *testing.T) {
+	doc := decodeJSONDocument(t, `{"user":{"name":"hermannm"}}`)
+	option := opt.FromJSONPath(doc, "user", "name", "first")
+
+	if !option.IsEmpty() {
+		t.Errorf("IsEmpty: want true (got value %v)", option.Value)
+	}
+}
+
+func TestMarshalCompact(t *testing.T) {
+	options := map[string]opt.Option[int]{
+		"a": opt.Value(1),
+		"b": opt.Empty[int](),
+		"c": opt.Value(0),
+		"d": opt.Empty[int](),
+	}
+
+	jsonValue, err := opt.MarshalCompact(options)
+	if err != nil {
+		t.Fatalf("MarshalCompact error: %v", err)
+	}
+
+	expected := `{"a":1,"c":0}`
+	if string(jsonValue) != expected {
+		t.Errorf("MarshalCompact() = %s; want %s", string(jsonValue), expected)
+	}
+}
+
+func TestJSONEqualBothEmpty(t *testing.T) {
+	equal, err := opt.JSONEqual(opt.Empty[int](), opt.Empty[int]())
+	if err != nil {
+		t.Fatalf("JSONEqual error: %v", err)
+	}
+	if !equal {
+		t.Error("JSONEqual() = false; want true")
+	}
+}
+
+func TestJSONEqualEmptyAndValue(t *testing.T) {
+	equal, err := opt.JSONEqual(opt.Empty[int](), opt.Value(0))
+	if err != nil {
+		t.Fatalf("JSONEqual error: %v", err)
+	}
+	if equal {
+		t.Error("JSONEqual() = true; want false")
+	}
+}
+
+func TestJSONEqualEqualValues(t *testing.T) {
+	a := opt.Value(map[string]int{"x": 1, "y": 2})
+	b := opt.Value(map[string]int{"y": 2, "x": 1})
+
+	equal, err := opt.JSONEqual(a, b)
+	if err != nil {
+		t.Fatalf("JSONEqual error: %v", err)
+	}
+	if !equal {
+		t.Error("JSONEqual() = false; want true")
+	}
+}
+
+func TestOverlayJSON(t *testing.T) {
+	type config struct {
+		Host    opt.Option[string] `json:"host"`
+		Port    opt.Option[int]    `json:"port"`
+		Timeout opt.Option[int]    `json:"timeout"`
+	}
+
+	base := []byte(`{"host":"localhost","port":8080,"timeout":30}`)
+	override := []byte(`{"host":"example.com","port":null}`)
+
+	var target config
+	if err := opt.OverlayJSON(base, override, &target); err != nil {
+		t.Fatalf("OverlayJSON error: %v", err)
+	}
+
+	if !target.Host.HasValue() || target.Host.Value != "example.com" {
+		t.Errorf("Host = %v; want 'example.com'", target.Host)
+	}
+	if !target.Port.IsEmpty() {
+		t.Errorf("Port = %v; want <empty>", target.Port)
+	}
+	if !target.Timeout.HasValue() || target.Timeout.Value != 30 {
+		t.Errorf("Timeout = %v; want 30", target.Timeout)
+	}
+}
+
+func TestOverlayJSONReplacesMapsAndStructs(t *testing.T) {
+	type limits struct {
+		A int
+		B int
+	}
+	type config struct {
+		M opt.Option[map[string]int] `json:"m"`
+		S opt.Option[limits]         `json:"s"`
+	}
+
+	base := []byte(`{"m":{"a":1},"s":{"A":1,"B":2}}`)
+	override := []byte(`{"m":{"b":2},"s":{"A":5}}`)
+
+	var target config
+	if err := opt.OverlayJSON(base, override, &target); err != nil {
+		t.Fatalf("OverlayJSON error: %v", err)
+	}
+
+	if !target.M.HasValue() || len(target.M.Value) != 1 || target.M.Value["b"] != 2 {
+		t.Errorf("M = %v; want map[b:2]", target.M)
+	}
+	if !target.S.HasValue() || target.S.Value != (limits{A: 5}) {
+		t.Errorf("S = %v; want {5 0}", target.S)
+	}
+}
+
+func TestMarshalSortedMap(t *testing.T) {
+	options := map[string]opt.Option[int]{
+		"d": opt.Value(4),
+		"b": opt.Empty[int](),
+		"a": opt.Value(1),
+		"c": opt.Value(3),
+	}
+
+	expected := `{"a":1,"b":null,"c":3,"d":4}`
+	for range 10 {
+		jsonValue, err := opt.MarshalSortedMap(options, false)
+		if err != nil {
+			t.Fatalf("MarshalSortedMap error: %v", err)
+		}
+		if string(jsonValue) != expected {
+			t.Fatalf("MarshalSortedMap() = %s; want %s", string(jsonValue), expected)
+		}
+	}
+}
+
+func TestMarshalSortedMapOmitEmpty(t *testing.T) {
+	options := map[string]opt.Option[string]{
+		"z": opt.Value("last"),
+		"m": opt.Empty[string](),
+		"a": opt.Value("first"),
+	}
+
+	jsonValue, err := opt.MarshalSortedMap(options, true)
+	if err != nil {
+		t.Fatalf("MarshalSortedMap error: %v", err)
+	}
+
+	expected := `{"a":"first","z":"last"}`
+	if string(jsonValue) != expected {
+		t.Errorf("MarshalSortedMap() = %s; want %s", string(jsonValue), expected)
+	}
+}
+
+func TestMarshalNDJSON(t *testing.T) {
+	options := []opt.Option[string]{opt.Value("a"), opt.Empty[string](), opt.Value("c")}
+
+	var output bytes.Buffer
+	if err := opt.MarshalNDJSON(options, &output); err != nil {
+		t.Fatalf("MarshalNDJSON error: %v", err)
+	}
+
+	expected := "\"a\"\nnull\n\"c\"\n"
+	if output.String() != expected {
+		t.Fatalf("MarshalNDJSON() = %q; want %q", output.String(), expected)
+	}
+
+	lines := bytes.Split(bytes.TrimSuffix(output.Bytes(), []byte{'\n'}), []byte{'\n'})
+	for i, line := range lines {
+		var decoded opt.Option[string]
+		if err := json.Unmarshal(line, &decoded); err != nil {
+			t.Fatalf("json.Unmarshal error on line %d: %v", i, err)
+		}
+		if decoded != options[i] {
+			t.Errorf("line %d = %v; want %v", i, decoded, options[i])
+		}
+	}
+}
+
+func TestFromJSONNumberInteger(t *testing.T) {
+	option := opt.FromJSONNumber(json.Number("42"))
+
+	if !option.HasValue() {
+		t.Fatal("HasValue: want true")
+	}
+	if value, ok := option.Value.(int64); !ok || value != 42 {
+		t.Errorf("Value = %#v; want int64(42)", option.Value)
+	}
+}
+
+func TestFromJSONNumberFloat(t *testing.T) {
+	option := opt.FromJSONNumber(json.Number("4.5"))
+
+	if !option.HasValue() {
+		t.Fatal("HasValue: want true")
+	}
+	if value, ok := option.Value.(float64); !ok || value != 4.5 {
+		t.Errorf("Value = %#v; want float64(4.5)", option.Value)
+	}
+}
+
+func TestFromJSONNumberIntegralFloat(t *testing.T) {
+	for number, expected := range map[json.Number]int64{"1.0": 1, "1e3": 1000, "-2.50e1": -25} {
+		option := opt.FromJSONNumber(number)
+
+		if value, ok := option.Value.(int64); !option.HasValue() || !ok || value != expected {
+			t.Errorf("FromJSONNumber(%s) = %#v; want int64(%d)", number, option.Value, expected)
+		}
+	}
+}
+
+func TestFromJSONNumberIntegralFloatBeyondPrecision(t *testing.T) {
+	option := opt.FromJSONNumber(json.Number("9007199254740993.0"))
+
+	if _, ok := option.Value.(float64); !option.HasValue() || !ok {
+		t.Errorf("Value = %#v; want float64", option.Value)
+	}
+}
+
+func TestFromJSONNumberLargeIntegerLiteral(t *testing.T) {
+	option := opt.FromJSONNumber(json.Number("9007199254740993"))
+
+	if value, ok := option.Value.(int64); !option.HasValue() || !ok || value != 9007199254740993 {
+		t.Errorf("Value = %#v; want int64(9007199254740993)", option.Value)
+	}
+}
+
+func TestFromJSONNumberOutOfInt64Range(t *testing.T) {
+	option := opt.FromJSONNumber(json.Number("1e19"))
+
+	if value, ok := option.Value.(float64); !option.HasValue() || !ok || value != 1e19 {
+		t.Errorf("Value = %#v; want float64(1e19)", option.Value)
+	}
+}
+
+func TestFromJSONNumberInvalid(t *testing.T) {
+	option := opt.FromJSONNumber(json.Number("not a number"))
+
+	if !option.IsEmpty() {
+		t.Errorf("FromJSONNumber() = %v; want <empty>", option)
+	}
+}
@@ -0,0 +1,13 @@
+package opt
+
+// Remove deletes the given key from the map, and returns an option containing the value that was
+// removed. If the key was not in the map, an empty option is returned, and the map is unchanged.
+func Remove[K comparable, V any](m map[K]V, key K) Option[V] {
+	value, ok := m[key]
+	if !ok {
+		return Option[V]{hasValue: false}
+	}
+
+	delete(m, key)
+	return Option[V]{hasValue: true, Value: value}
+}
@@ -0,0 +1,34 @@
+package opt_test
+
+import (
+	"testing"
+
+	"hermannm.dev/opt"
+)
+
+func TestRemovePresentKey(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	option := opt.Remove(m, "a")
+
+	if !option.HasValue() || option.Value != 1 {
+		t.Errorf("Remove() = %v; want 1", option)
+	}
+	if _, ok := m["a"]; ok {
+		t.Error("key 'a' still in map after Remove")
+	}
+	if len(m) != 1 {
+		t.Errorf("len(m) = %d; want 1", len(m))
+	}
+}
+
+func TestRemoveAbsentKey(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	option := opt.Remove(m, "c")
+
+	if !option.IsEmpty() {
+		t.Errorf("Remove() = %v; want <empty>", option)
+	}
+	if len(m) != 2 {
+		t.Errorf("len(m) = %d; want 2", len(m))
+	}
+}
@@ -0,0 +1,98 @@
+package opt
+
+// Integer is a constraint that permits any integer type.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float is a constraint that permits any floating-point type.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	Integer | Float
+}
+
+// Convert converts the value of the given option from one numeric type to another, using a regular
+// Go type conversion (e.g. Option[int32] to Option[int64]). An empty option returns an empty
+// option.
+//
+// As with regular conversions, a value that does not fit in the target type is truncated or
+// wrapped around. Use [NarrowInt] to get an empty option instead for integers.
+func Convert[From Number, To Number](option Option[From]) Option[To] {
+	if option.hasValue {
+		return Option[To]{hasValue: true, Value: To(option.Value)}
+	} else {
+		return Option[To]{hasValue: false}
+	}
+}
+
+// NarrowInt converts the value of the given option from one integer type to another. If the value
+// does not fit in the target type (for example, 300 in a uint8, or a negative number in an unsigned
+// type), an empty option is returned. An empty option also returns an empty option.
+func NarrowInt[From Integer, To Integer](option Option[From]) Option[To] {
+	if !option.hasValue {
+		return Option[To]{hasValue: false}
+	}
+
+	converted := To(option.Value)
+	if From(converted) != option.Value || (option.Value < 0) != (converted < 0) {
+		return Option[To]{hasValue: false}
+	}
+
+	return Option[To]{hasValue: true, Value: converted}
+}
+
+// Add returns an option containing a + b if both options have values, or an empty option if either
+// is empty.
+func Add[T Number](a Option[T], b Option[T]) Option[T] {
+	if a.hasValue && b.hasValue {
+		return Option[T]{hasValue: true, Value: a.Value + b.Value}
+	} else {
+		return Option[T]{hasValue: false}
+	}
+}
+
+// Sub returns an option containing a - b if both options have values, or an empty option if either
+// is empty.
+func Sub[T Number](a Option[T], b Option[T]) Option[T] {
+	if a.hasValue && b.hasValue {
+		return Option[T]{hasValue: true, Value: a.Value - b.Value}
+	} else {
+		return Option[T]{hasValue: false}
+	}
+}
+
+// Mul returns an option containing a * b if both options have values, or an empty option if either
+// is empty.
+func Mul[T Number](a Option[T], b Option[T]) Option[T] {
+	if a.hasValue && b.hasValue {
+		return Option[T]{hasValue: true, Value: a.Value * b.Value}
+	} else {
+		return Option[T]{hasValue: false}
+	}
+}
+
+// Positive returns an option containing the given value if it is greater than 0, or an empty
+// option otherwise. This is useful for config values where 0 or a negative number means "unset".
+func Positive[T Number](value T) Option[T] {
+	if value > 0 {
+		return Option[T]{hasValue: true, Value: value}
+	} else {
+		return Option[T]{hasValue: false}
+	}
+}
+
+// NonNegative returns an option containing the given value if it is greater than or equal to 0, or
+// an empty option otherwise. This is useful for config values where a negative number means
+// "unset".
+func NonNegative[T Number](value T) Option[T] {
+	if value >= 0 {
+		return Option[T]{hasValue: true, Value: value}
+	} else {
+		return Option[T]{hasValue: false}
+	}
+}
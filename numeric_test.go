@@ -0,0 +1,133 @@
+package opt_test
+
+import (
+	"testing"
+
+	"hermannm.dev/opt"
+)
+
+func TestConvert(t *testing.T) {
+	wide := opt.Convert[int32, int64](opt.Value[int32](5))
+	if !wide.HasValue() || wide.Value != 5 {
+		t.Errorf("Convert() = %v; want 5", wide)
+	}
+
+	float := opt.Convert[int, float64](opt.Value(2))
+	if !float.HasValue() || float.Value != 2.0 {
+		t.Errorf("Convert() = %v; want 2.0", float)
+	}
+
+	if option := opt.Convert[int32, int64](opt.Empty[int32]()); !option.IsEmpty() {
+		t.Errorf("Convert() = %v; want <empty>", option)
+	}
+}
+
+func TestNarrowIntInRange(t *testing.T) {
+	option := opt.NarrowInt[int64, int8](opt.Value[int64](100))
+
+	if !option.HasValue() {
+		t.Fatal("HasValue: want true")
+	}
+	if option.Value != 100 {
+		t.Errorf("Value = %d; want 100", option.Value)
+	}
+}
+
+func TestNarrowIntOverflow(t *testing.T) {
+	option := opt.NarrowInt[int, uint8](opt.Value(300))
+
+	if !option.IsEmpty() {
+		t.Errorf("IsEmpty: want true (got value %d)", option.Value)
+	}
+}
+
+func TestNarrowIntNegativeToUnsigned(t *testing.T) {
+	option := opt.NarrowInt[int32, uint64](opt.Value[int32](-1))
+
+	if !option.IsEmpty() {
+		t.Errorf("IsEmpty: want true (got value %d)", option.Value)
+	}
+}
+
+func TestNarrowIntEmpty(t *testing.T) {
+	option := opt.NarrowInt[int, int16](opt.Empty[int]())
+
+	if !option.IsEmpty() {
+		t.Error("IsEmpty: want true")
+	}
+}
+
+func TestSubBothPresent(t *testing.T) {
+	option := opt.Sub(opt.Value(10), opt.Value(3))
+
+	if !option.HasValue() {
+		t.Fatal("HasValue: want true")
+	}
+	if option.Value != 7 {
+		t.Errorf("Value = %d; want 7", option.Value)
+	}
+}
+
+func TestSubFirstEmpty(t *testing.T) {
+	option := opt.Sub(opt.Empty[int](), opt.Value(3))
+
+	if !option.IsEmpty() {
+		t.Errorf("IsEmpty: want true (got value %d)", option.Value)
+	}
+}
+
+func TestSubSecondEmpty(t *testing.T) {
+	option := opt.Sub(opt.Value(10), opt.Empty[int]())
+
+	if !option.IsEmpty() {
+		t.Errorf("IsEmpty: want true (got value %d)", option.Value)
+	}
+}
+
+func TestSubBothEmpty(t *testing.T) {
+	option := opt.Sub(opt.Empty[int](), opt.Empty[int]())
+
+	if !option.IsEmpty() {
+		t.Errorf("IsEmpty: want true (got value %d)", option.Value)
+	}
+}
+
+func TestAddAndMul(t *testing.T) {
+	sum := opt.Add(opt.Value(1.5), opt.Value(2.0))
+	if !sum.HasValue() || sum.Value != 3.5 {
+		t.Errorf("Add() = %v; want 3.5", sum)
+	}
+
+	product := opt.Mul(opt.Value(4), opt.Value(5))
+	if !product.HasValue() || product.Value != 20 {
+		t.Errorf("Mul() = %v; want 20", product)
+	}
+
+	if option := opt.Mul(opt.Value(4), opt.Empty[int]()); !option.IsEmpty() {
+		t.Errorf("Mul() = %v; want <empty>", option)
+	}
+}
+
+func TestPositive(t *testing.T) {
+	if option := opt.Positive(0); !option.IsEmpty() {
+		t.Errorf("Positive(0) = %v; want <empty>", option)
+	}
+	if option := opt.Positive(-1); !option.IsEmpty() {
+		t.Errorf("Positive(-1) = %v; want <empty>", option)
+	}
+	if option := opt.Positive(1.5); !option.HasValue() || option.Value != 1.5 {
+		t.Errorf("Positive(1.5) = %v; want 1.5", option)
+	}
+}
+
+func TestNonNegative(t *testing.T) {
+	if option := opt.NonNegative(0); !option.HasValue() || option.Value != 0 {
+		t.Errorf("NonNegative(0) = %v; want 0", option)
+	}
+	if option := opt.NonNegative(-1); !option.IsEmpty() {
+		t.Errorf("NonNegative(-1) = %v; want <empty>", option)
+	}
+	if option := opt.NonNegative(5); !option.HasValue() || option.Value != 5 {
+		t.Errorf("NonNegative(5) = %v; want 5", option)
+	}
+}
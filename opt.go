@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"reflect"
 )
 
 // Option is a container that either has a value, or is empty. You construct an option with [Value],
@@ -15,6 +16,11 @@ import (
 //
 // An empty option marshals to `null` in JSON, and a `null` JSON value unmarshals to an empty
 // option.
+//
+// All functions and methods in this package that produce empty options reset the Value field to
+// its zero value, so for comparable T, options can be compared with == and used as map keys. If
+// you have set the Value field of an empty option directly, use [Option.Normalized] to restore
+// this.
 type Option[T any] struct {
 	hasValue bool
 	// Before accessing Value, you should check if it is present with [Option.HasValue].
@@ -38,6 +44,10 @@ func Empty[T any]() Option[T] {
 
 // FromPointer creates an [Option] with the value pointed to by the given pointer, dereferencing it.
 // If the pointer is nil, an empty option is returned.
+//
+// Only the outer pointer is checked: if T is itself a pointer type, and the given pointer points to
+// a nil pointer, the returned option has a value (the nil inner pointer). Use [FromPointerDeep] to
+// treat a nil inner pointer as empty.
 func FromPointer[T any](pointer *T) Option[T] {
 	if pointer == nil {
 		return Option[T]{
@@ -51,6 +61,80 @@ func FromPointer[T any](pointer *T) Option[T] {
 	}
 }
 
+// FromPointerDeep creates an [Option] with the value pointed to by the given pointer-to-pointer,
+// dereferencing it twice. If either the outer or the inner pointer is nil, an empty option is
+// returned.
+func FromPointerDeep[T any](pointer **T) Option[T] {
+	if pointer == nil || *pointer == nil {
+		return Option[T]{hasValue: false}
+	} else {
+		return Option[T]{hasValue: true, Value: **pointer}
+	}
+}
+
+// FromOk creates an [Option] from a value and an `ok` flag, as returned by map lookups, type
+// assertions and channel receives. If ok is false, an empty option is returned, and the value is
+// ignored. Since Go passes multiple return values through to a function call, you can write:
+//
+//	option := opt.FromOk(cache.Get(key))
+//
+// Note that the comma-ok forms of map lookups, type assertions and channel receives are not
+// regular multi-value calls, so those must first be assigned to variables.
+//
+// For values stored in separate value and "valid" columns, see [FromColumns], which does the same.
+func FromOk[T any](value T, ok bool) Option[T] {
+	if ok {
+		return Option[T]{hasValue: true, Value: value}
+	} else {
+		return Option[T]{hasValue: false}
+	}
+}
+
+// FromResult creates an [Option] from the return values of a function with the signature
+// `func() (T, error)`. If err is nil, an option containing the value is returned. If err is
+// non-nil, an empty option is returned, and the error is discarded (use [FromErr] to keep it):
+//
+//	port := opt.FromResult(strconv.Atoi(portString))
+func FromResult[T any](value T, err error) Option[T] {
+	if err == nil {
+		return Option[T]{hasValue: true, Value: value}
+	} else {
+		return Option[T]{hasValue: false}
+	}
+}
+
+// FromErr works like [FromResult], but also returns the error, for when you want to log it or
+// handle some errors differently.
+func FromErr[T any](value T, err error) (Option[T], error) {
+	if err == nil {
+		return Option[T]{hasValue: true, Value: value}, nil
+	} else {
+		return Option[T]{hasValue: false}, err
+	}
+}
+
+// FromZero creates an [Option] that is empty if the given value is the zero value of its type (for
+// example an empty string, 0 or a zero [time.Time]), and otherwise contains the value. This is
+// useful for bridging from structs that use zero values to mean "unset".
+func FromZero[T comparable](value T) Option[T] {
+	var zero T
+	if value == zero {
+		return Option[T]{hasValue: false}
+	} else {
+		return Option[T]{hasValue: true, Value: value}
+	}
+}
+
+// Cast performs a type assertion of the given value to T, and returns an option containing the
+// result if it succeeds, or an empty option if the value is not of type T (or is nil).
+func Cast[T any](value any) Option[T] {
+	if typedValue, ok := value.(T); ok {
+		return Option[T]{hasValue: true, Value: typedValue}
+	} else {
+		return Option[T]{hasValue: false}
+	}
+}
+
 // HasValue returns true if the option contains a value.
 func (option Option[T]) HasValue() bool {
 	return option.hasValue
@@ -63,10 +147,43 @@ func (option Option[T]) IsEmpty() bool {
 
 // Get returns the value of the option, and an `ok` flag that is true if the option contained a
 // value, and false if it is empty. You should only use the returned value if `ok` is true.
+//
+// Get can also be used to store an option in two separate columns, e.g. a value column and a
+// boolean "has value" column in a database table.
 func (option Option[T]) Get() (value T, ok bool) {
 	return option.Value, option.hasValue
 }
 
+// MustGet returns the value of the option, or panics if the option is empty. You should only use
+// this when an empty option is a programming error, e.g. in tests or program initialization.
+func (option Option[T]) MustGet() T {
+	if !option.hasValue {
+		panic("opt: MustGet called on empty option")
+	}
+	return option.Value
+}
+
+// Expect returns the value of the option, or panics with the given message if the option is empty.
+// Like [Option.MustGet], you should only use this when an empty option is a programming error.
+func (option Option[T]) Expect(message string) T {
+	if !option.hasValue {
+		panic(message)
+	}
+	return option.Value
+}
+
+// GetIf returns the value of the option, and an `ok` flag that is true if the option contained a
+// value that satisfies the given predicate. If the option is empty, or the value does not satisfy
+// the predicate, it returns the zero value and false.
+func (option Option[T]) GetIf(predicate func(value T) bool) (value T, ok bool) {
+	if option.hasValue && predicate(option.Value) {
+		return option.Value, true
+	} else {
+		var zero T
+		return zero, false
+	}
+}
+
 // GetOrDefault returns the option's value if present, or the given default value if the option is
 // empty.
 func (option Option[T]) GetOrDefault(defaultValue T) T {
@@ -77,6 +194,67 @@ func (option Option[T]) GetOrDefault(defaultValue T) T {
 	}
 }
 
+// GetOrElse returns the option's value if present. If the option is empty, it calls the given
+// function and returns its result. Unlike [Option.GetOrDefault], the default is only computed if
+// needed, which is useful when it is expensive.
+func (option Option[T]) GetOrElse(defaultFn func() T) T {
+	if option.hasValue {
+		return option.Value
+	} else {
+		return defaultFn()
+	}
+}
+
+// GetOrZero returns the option's value if present, or the zero value of T if the option is empty.
+func (option Option[T]) GetOrZero() T {
+	if option.hasValue {
+		return option.Value
+	} else {
+		var zero T
+		return zero
+	}
+}
+
+// NonZero returns an empty option if the option is empty or its value is the zero value of its
+// type, and otherwise returns the option unchanged. Like [FromZero], this treats zero values as
+// "unset", but works on an existing option, for any type T (using [reflect.Value.IsZero]).
+func (option Option[T]) NonZero() Option[T] {
+	if option.hasValue && !reflect.ValueOf(&option.Value).Elem().IsZero() {
+		return option
+	} else {
+		return Option[T]{hasValue: false}
+	}
+}
+
+// Or returns the option if it has a value, or else the given fallback option. Chain calls to
+// express precedence, e.g. `fromFlag.Or(fromEnv).Or(fromConfigFile)`.
+func (option Option[T]) Or(fallback Option[T]) Option[T] {
+	if option.hasValue {
+		return option
+	} else {
+		return fallback.Normalized()
+	}
+}
+
+// OrElse returns the option if it has a value, or else the option returned by the given function.
+// Unlike [Option.Or], the fallback is only computed if needed.
+func (option Option[T]) OrElse(fallback func() Option[T]) Option[T] {
+	if option.hasValue {
+		return option
+	} else {
+		return fallback().Normalized()
+	}
+}
+
+// And returns the other option if this option has a value, or else an empty option.
+func (option Option[T]) And(other Option[T]) Option[T] {
+	if option.hasValue {
+		return other.Normalized()
+	} else {
+		return Option[T]{hasValue: false}
+	}
+}
+
 // Put replaces the current value of the option, if any, with the given value. After this call,
 // [Option.HasValue] will return true.
 func (option *Option[T]) Put(value T) {
@@ -90,10 +268,113 @@ func (option *Option[T]) Clear() {
 	*option = Option[T]{hasValue: false}
 }
 
+// Take returns the option's value and an `ok` flag (like [Option.Get]), and leaves the option
+// empty. You should only use the returned value if `ok` is true.
+func (option *Option[T]) Take() (value T, ok bool) {
+	value, ok = option.Value, option.hasValue
+	*option = Option[T]{hasValue: false}
+	return value, ok
+}
+
+// Replace puts the given value in the option (like [Option.Put]), and returns the previous
+// contents of the option.
+func (option *Option[T]) Replace(value T) Option[T] {
+	previous := option.Normalized()
+	*option = Option[T]{hasValue: true, Value: value}
+	return previous
+}
+
+// Swap exchanges the contents of the two given options.
+func Swap[T any](a *Option[T], b *Option[T]) {
+	*a, *b = *b, *a
+}
+
+// GetOrInsert puts the given value in the option if it is empty, and then returns a pointer to the
+// option's value. The pointer points into the option itself, so it is only valid as long as the
+// option is.
+func (option *Option[T]) GetOrInsert(value T) *T {
+	if !option.hasValue {
+		option.Put(value)
+	}
+	return &option.Value
+}
+
+// GetOrInsertWith works like [Option.GetOrInsert], but only calls the given function to create the
+// value if the option is empty.
+func (option *Option[T]) GetOrInsertWith(valueFn func() T) *T {
+	if !option.hasValue {
+		option.Put(valueFn())
+	}
+	return &option.Value
+}
+
+// LoadThrough returns the option's value if it has one. Otherwise, it calls the given loader, and
+// if that succeeds, stores the loaded value in the option (so later calls return it without calling
+// the loader again) and returns it. If the loader fails, its error is returned, and the option is
+// left empty.
+func (option *Option[T]) LoadThrough(loader func() (T, error)) (T, error) {
+	if option.hasValue {
+		return option.Value, nil
+	}
+
+	value, err := loader()
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	option.Put(value)
+	return value, nil
+}
+
+// AsPointer returns a pointer to the option's own value if it has one, or nil if it is empty.
+// Unlike [Option.ToPointer], which returns a pointer to a copy of the value, this lets you modify
+// the value in place, and avoids copying large values.
+//
+// The pointer is only valid as long as the option is, and should not be used after the option is
+// cleared or replaced.
+func (option *Option[T]) AsPointer() *T {
+	if option.hasValue {
+		return &option.Value
+	} else {
+		return nil
+	}
+}
+
+// Clone returns a copy of the option. If T (or *T) has a `Clone() T` method, it is used to copy the
+// value, so types that contain references (such as slices or maps) can implement it to make a deep
+// copy. Otherwise, the value is copied as normal (a shallow copy).
+func (option Option[T]) Clone() Option[T] {
+	if !option.hasValue {
+		return Option[T]{hasValue: false}
+	}
+
+	if cloner, ok := any(option.Value).(interface{ Clone() T }); ok {
+		return Option[T]{hasValue: true, Value: cloner.Clone()}
+	}
+	if cloner, ok := any(&option.Value).(interface{ Clone() T }); ok {
+		return Option[T]{hasValue: true, Value: cloner.Clone()}
+	}
+	return option
+}
+
+// Normalized returns the option in canonical form: if the option is empty, its Value field is reset
+// to the zero value. This ensures that all empty options of a comparable type are equal under ==.
+// Options with values are returned unchanged.
+func (option Option[T]) Normalized() Option[T] {
+	if option.hasValue {
+		return option
+	} else {
+		return Option[T]{hasValue: false}
+	}
+}
+
 // ToPointer returns nil if the option is empty, otherwise it returns a pointer to the option's
 // value.
 //
 // It is meant to be used for compatibility with libraries that use pointers for optional values.
+// The returned pointer points to a copy of the value, so modifying it does not affect the option.
+// To modify the value in place, use [Option.AsPointer].
 func (option Option[T]) ToPointer() *T {
 	if option.hasValue {
 		return &option.Value
@@ -102,6 +383,118 @@ func (option Option[T]) ToPointer() *T {
 	}
 }
 
+// Then calls the given function with the option's value, and returns an option containing the
+// result. If the option is empty, an empty option is returned, and the function is not called.
+//
+// Since Go methods cannot have their own type parameters, the function cannot change the value's
+// type. Use [Map] for that.
+func (option Option[T]) Then(fn func(value T) T) Option[T] {
+	if option.hasValue {
+		return Option[T]{hasValue: true, Value: fn(option.Value)}
+	} else {
+		return Option[T]{hasValue: false}
+	}
+}
+
+// ThenTry works like [Option.Then], but for functions that can fail. If the function returns an
+// error, an empty option is returned along with the error.
+func (option Option[T]) ThenTry(fn func(value T) (T, error)) (Option[T], error) {
+	if !option.hasValue {
+		return Option[T]{hasValue: false}, nil
+	}
+
+	value, err := fn(option.Value)
+	if err != nil {
+		return Option[T]{hasValue: false}, err
+	}
+	return Option[T]{hasValue: true, Value: value}, nil
+}
+
+// Filter returns the option unchanged if it has a value that satisfies the given predicate.
+// Otherwise, it returns an empty option. If the option is empty, the predicate is not called.
+func (option Option[T]) Filter(predicate func(value T) bool) Option[T] {
+	if option.hasValue && predicate(option.Value) {
+		return option
+	} else {
+		return Option[T]{hasValue: false}
+	}
+}
+
+// FilterWithRejected checks the option's value against the given predicate. If the value passes,
+// it is returned in kept, and rejected is empty. If the value fails, it is returned in rejected,
+// and kept is empty. If the option is empty, both returned options are empty, and the predicate
+// is not called.
+func (option Option[T]) FilterWithRejected(
+	predicate func(value T) bool,
+) (kept Option[T], rejected Option[T]) {
+	if !option.hasValue {
+		return Option[T]{hasValue: false}, Option[T]{hasValue: false}
+	}
+
+	if predicate(option.Value) {
+		return option, Option[T]{hasValue: false}
+	} else {
+		return Option[T]{hasValue: false}, option
+	}
+}
+
+// IfPresent calls the given function with the option's value, if the option has a value.
+func (option Option[T]) IfPresent(fn func(value T)) {
+	if option.hasValue {
+		fn(option.Value)
+	}
+}
+
+// IfEmpty calls the given function if the option is empty.
+func (option Option[T]) IfEmpty(fn func()) {
+	if !option.hasValue {
+		fn()
+	}
+}
+
+// Inspect calls the given function with the option's value, if the option has a value, and then
+// returns the option. This allows side effects such as logging in a chain of calls.
+func (option Option[T]) Inspect(fn func(value T)) Option[T] {
+	if option.hasValue {
+		fn(option.Value)
+	}
+	return option.Normalized()
+}
+
+// Dispatch calls each of the given handlers in order with the option's value, if the option has a
+// value. If the option is empty, no handlers are called.
+func (option Option[T]) Dispatch(handlers ...func(value T)) {
+	if !option.hasValue {
+		return
+	}
+
+	for _, handler := range handlers {
+		handler(option.Value)
+	}
+}
+
+// Row returns a single table row with the columns of the option's value (as returned by the given
+// function), or no rows if the option is empty. This lets optional records be passed to table
+// writers the same way as lists of records.
+func (option Option[T]) Row(columns func(value T) []string) [][]string {
+	if option.hasValue {
+		return [][]string{columns(option.Value)}
+	} else {
+		return nil
+	}
+}
+
+// ToGraphQL converts the option to the `(value, isNull)` shape used by some GraphQL resolvers. If
+// the option has a value, it returns the value and false. If the option is empty, it returns nil
+// and true.
+func (option Option[T]) ToGraphQL() (value any, isNull bool) {
+	if option.hasValue {
+		return option.Value, false
+	} else {
+		return nil, true
+	}
+}
+
 // FromSQL creates an [Option] from the given [sql.Null] value. A null SQL value becomes an empty
 // option, and a non-null SQL value becomes an option containing the value.
 func FromSQL[T any](sql sql.Null[T]) Option[T] {
@@ -138,8 +531,9 @@ func (option Option[T]) MarshalJSON() ([]byte, error) {
 }
 
 // UnmarshalJSON implements the [json.Unmarshaler] interface for [Option]. If the given JSON value
-// is `null`, it unmarshals to an empty option. Otherwise, it tries to unmarshal to the value
-// contained by the option.
+// is `null`, it unmarshals to an empty option. Otherwise, it unmarshals to a new value of type T,
+// which replaces any previous value in the option. If unmarshaling fails, the option is left
+// unchanged.
 func (option *Option[T]) UnmarshalJSON(jsonValue []byte) error {
 	isNull := len(jsonValue) == 4 &&
 		jsonValue[0] == 'n' &&
@@ -148,10 +542,16 @@ func (option *Option[T]) UnmarshalJSON(jsonValue []byte) error {
 		jsonValue[3] == 'l'
 
 	if isNull {
-		option.hasValue = false
+		*option = Option[T]{hasValue: false}
 		return nil
-	} else {
-		option.hasValue = true
-		return json.Unmarshal(jsonValue, &option.Value)
 	}
+
+	// Decode into a fresh value, so that a failed unmarshal leaves the option untouched, and a
+	// successful one does not merge maps or structs into the previous value
+	var value T
+	if err := json.Unmarshal(jsonValue, &value); err != nil {
+		return err
+	}
+	*option = Option[T]{hasValue: true, Value: value}
+	return nil
 }
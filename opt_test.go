@@ -3,7 +3,13 @@ package opt_test
 import (
 	"database/sql"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"hermannm.dev/opt"
 )
@@ -67,6 +73,195 @@ func TestFromNilPointer(t *testing.T) {
 	}
 }
 
+func TestGetValueAndPresence(t *testing.T) {
+	valueColumn, presentColumn := opt.Value(0).Get()
+	if valueColumn != 0 || !presentColumn {
+		t.Errorf("Get() = %d, %t; want 0, true", valueColumn, presentColumn)
+	}
+
+	valueColumn, presentColumn = opt.Empty[int]().Get()
+	if valueColumn != 0 || presentColumn {
+		t.Errorf("Get() = %d, %t; want 0, false", valueColumn, presentColumn)
+	}
+}
+
+func TestMustGet(t *testing.T) {
+	if value := opt.Value("test").MustGet(); value != "test" {
+		t.Errorf("MustGet() = %s; want 'test'", value)
+	}
+}
+
+func TestMustGetEmpty(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("MustGet: want panic on empty option")
+		}
+	}()
+
+	opt.Empty[string]().MustGet()
+}
+
+func TestExpect(t *testing.T) {
+	if value := opt.Value("test").Expect("name must be set"); value != "test" {
+		t.Errorf("Expect() = %s; want 'test'", value)
+	}
+}
+
+func TestExpectEmpty(t *testing.T) {
+	defer func() {
+		if recovered := recover(); recovered != "name must be set" {
+			t.Errorf("Expect panicked with %v; want 'name must be set'", recovered)
+		}
+	}()
+
+	opt.Empty[string]().Expect("name must be set")
+}
+
+func TestGetIfEmpty(t *testing.T) {
+	value, ok := opt.Empty[int]().GetIf(isEven)
+
+	if ok || value != 0 {
+		t.Errorf("GetIf() = %d, %t; want 0, false", value, ok)
+	}
+}
+
+func TestGetIfPassing(t *testing.T) {
+	value, ok := opt.Value(2).GetIf(isEven)
+
+	if !ok || value != 2 {
+		t.Errorf("GetIf() = %d, %t; want 2, true", value, ok)
+	}
+}
+
+func TestGetIfFailing(t *testing.T) {
+	value, ok := opt.Value(3).GetIf(isEven)
+
+	if ok || value != 0 {
+		t.Errorf("GetIf() = %d, %t; want 0, false", value, ok)
+	}
+}
+
+func TestFromPointerToNilPointer(t *testing.T) {
+	var inner *string
+	option := opt.FromPointer(&inner)
+
+	// FromPointer only checks the outer pointer, so the option has a value: the nil inner pointer.
+	if !option.HasValue() {
+		t.Error("HasValue: want true")
+	}
+	if option.Value != nil {
+		t.Errorf("Value = %v; want nil", option.Value)
+	}
+}
+
+func TestFromPointerDeep(t *testing.T) {
+	value := "test"
+	inner := &value
+	option := opt.FromPointerDeep(&inner)
+
+	if !option.HasValue() || option.Value != "test" {
+		t.Errorf("FromPointerDeep() = %v; want 'test'", option)
+	}
+}
+
+func TestFromPointerDeepNil(t *testing.T) {
+	var inner *string
+	if option := opt.FromPointerDeep(&inner); !option.IsEmpty() {
+		t.Errorf("FromPointerDeep(&nil) = %v; want <empty>", option)
+	}
+
+	if option := opt.FromPointerDeep[string](nil); !option.IsEmpty() {
+		t.Errorf("FromPointerDeep(nil) = %v; want <empty>", option)
+	}
+}
+
+func TestFromOk(t *testing.T) {
+	m := map[string]int{"a": 1}
+
+	value, ok := m["a"]
+	if option := opt.FromOk(value, ok); !option.HasValue() || option.Value != 1 {
+		t.Errorf("FromOk() = %v; want 1", option)
+	}
+
+	value, ok = m["b"]
+	if option := opt.FromOk(value, ok); !option.IsEmpty() {
+		t.Errorf("FromOk() = %v; want <empty>", option)
+	}
+
+	cache := fakeCache{"key": 5}
+	if option := opt.FromOk(cache.Get("key")); !option.HasValue() || option.Value != 5 {
+		t.Errorf("FromOk() = %v; want 5", option)
+	}
+}
+
+func TestFromResult(t *testing.T) {
+	if option := opt.FromResult(strconv.Atoi("5")); !option.HasValue() || option.Value != 5 {
+		t.Errorf("FromResult() = %v; want 5", option)
+	}
+	if option := opt.FromResult(strconv.Atoi("abc")); !option.IsEmpty() {
+		t.Errorf("FromResult() = %v; want <empty>", option)
+	}
+}
+
+func TestFromErr(t *testing.T) {
+	option, err := opt.FromErr(strconv.Atoi("5"))
+	if err != nil || !option.HasValue() || option.Value != 5 {
+		t.Errorf("FromErr() = %v, %v; want 5, nil", option, err)
+	}
+
+	option, err = opt.FromErr(strconv.Atoi("abc"))
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("FromErr() error = %v; want %v", err, strconv.ErrSyntax)
+	}
+	if !option.IsEmpty() {
+		t.Errorf("FromErr() option = %v; want <empty>", option)
+	}
+}
+
+func TestFromZero(t *testing.T) {
+	if option := opt.FromZero(""); !option.IsEmpty() {
+		t.Errorf("FromZero('') = %v; want <empty>", option)
+	}
+	if option := opt.FromZero(time.Time{}); !option.IsEmpty() {
+		t.Errorf("FromZero(time.Time{}) = %v; want <empty>", option)
+	}
+	if option := opt.FromZero(5); !option.HasValue() || option.Value != 5 {
+		t.Errorf("FromZero(5) = %v; want 5", option)
+	}
+}
+
+func TestNonZero(t *testing.T) {
+	if option := opt.Value("").NonZero(); !option.IsEmpty() {
+		t.Errorf("Value('').NonZero() = %v; want <empty>", option)
+	}
+	if option := opt.Value([]int(nil)).NonZero(); !option.IsEmpty() {
+		t.Errorf("Value(nil slice).NonZero() = %v; want <empty>", option)
+	}
+	if option := opt.Value("test").NonZero(); !option.HasValue() || option.Value != "test" {
+		t.Errorf("Value('test').NonZero() = %v; want 'test'", option)
+	}
+	if option := opt.Empty[string]().NonZero(); !option.IsEmpty() {
+		t.Errorf("Empty().NonZero() = %v; want <empty>", option)
+	}
+}
+
+func TestCast(t *testing.T) {
+	var value any = "test"
+
+	if option := opt.Cast[string](value); !option.HasValue() || option.Value != "test" {
+		t.Errorf("Cast[string]() = %v; want 'test'", option)
+	}
+	if option := opt.Cast[int](value); !option.IsEmpty() {
+		t.Errorf("Cast[int]() = %v; want <empty>", option)
+	}
+	if option := opt.Cast[fmt.Stringer](stringer{"test"}); !option.HasValue() {
+		t.Errorf("Cast[fmt.Stringer]() = %v; want value", option)
+	}
+	if option := opt.Cast[string](nil); !option.IsEmpty() {
+		t.Errorf("Cast[string](nil) = %v; want <empty>", option)
+	}
+}
+
 func TestGetOrDefault(t *testing.T) {
 	option := opt.Empty[string]()
 
@@ -83,6 +278,69 @@ func TestGetOrDefault(t *testing.T) {
 	}
 }
 
+func TestGetOrElse(t *testing.T) {
+	value := opt.Value("value").GetOrElse(func() string {
+		t.Error("default function called on option with value")
+		return "default"
+	})
+	if value != "value" {
+		t.Errorf("GetOrElse() = %s; want 'value'", value)
+	}
+
+	value = opt.Empty[string]().GetOrElse(func() string { return "default" })
+	if value != "default" {
+		t.Errorf("GetOrElse() = %s; want 'default'", value)
+	}
+}
+
+func TestGetOrZero(t *testing.T) {
+	if value := opt.Value(5).GetOrZero(); value != 5 {
+		t.Errorf("GetOrZero() = %d; want 5", value)
+	}
+	if value := opt.Empty[int]().GetOrZero(); value != 0 {
+		t.Errorf("GetOrZero() = %d; want 0", value)
+	}
+}
+
+func TestOr(t *testing.T) {
+	if option := opt.Value("a").Or(opt.Value("b")); option.Value != "a" {
+		t.Errorf("Or() = %v; want 'a'", option)
+	}
+	if option := opt.Empty[string]().Or(opt.Value("b")); !option.HasValue() || option.Value != "b" {
+		t.Errorf("Or() = %v; want 'b'", option)
+	}
+	if option := opt.Empty[string]().Or(opt.Empty[string]()); !option.IsEmpty() {
+		t.Errorf("Or() = %v; want <empty>", option)
+	}
+}
+
+func TestOrElse(t *testing.T) {
+	option := opt.Value("a").OrElse(func() opt.Option[string] {
+		t.Error("fallback called on option with value")
+		return opt.Value("b")
+	})
+	if option.Value != "a" {
+		t.Errorf("OrElse() = %v; want 'a'", option)
+	}
+
+	option = opt.Empty[string]().OrElse(func() opt.Option[string] { return opt.Value("b") })
+	if !option.HasValue() || option.Value != "b" {
+		t.Errorf("OrElse() = %v; want 'b'", option)
+	}
+}
+
+func TestAnd(t *testing.T) {
+	if option := opt.Value("a").And(opt.Value("b")); !option.HasValue() || option.Value != "b" {
+		t.Errorf("And() = %v; want 'b'", option)
+	}
+	if option := opt.Value("a").And(opt.Empty[string]()); !option.IsEmpty() {
+		t.Errorf("And() = %v; want <empty>", option)
+	}
+	if option := opt.Empty[string]().And(opt.Value("b")); !option.IsEmpty() {
+		t.Errorf("And() = %v; want <empty>", option)
+	}
+}
+
 func TestPut(t *testing.T) {
 	option := opt.Empty[string]()
 	option.Put("test")
@@ -107,6 +365,87 @@ func TestClear(t *testing.T) {
 	}
 }
 
+func TestTake(t *testing.T) {
+	option := opt.Value("test")
+
+	value, ok := option.Take()
+	if value != "test" || !ok {
+		t.Errorf("Take() = %s, %t; want 'test', true", value, ok)
+	}
+	if !option.IsEmpty() || option.Value != "" {
+		t.Errorf("option after Take = %v; want <empty> with zero value", option)
+	}
+
+	value, ok = option.Take()
+	if value != "" || ok {
+		t.Errorf("second Take() = %s, %t; want '', false", value, ok)
+	}
+}
+
+func TestReplace(t *testing.T) {
+	option := opt.Empty[string]()
+
+	previous := option.Replace("first")
+	if !previous.IsEmpty() {
+		t.Errorf("Replace() = %v; want <empty>", previous)
+	}
+
+	previous = option.Replace("second")
+	if !previous.HasValue() || previous.Value != "first" {
+		t.Errorf("Replace() = %v; want 'first'", previous)
+	}
+	if !option.HasValue() || option.Value != "second" {
+		t.Errorf("option after Replace = %v; want 'second'", option)
+	}
+}
+
+func TestSwap(t *testing.T) {
+	a := opt.Value("a")
+	b := opt.Empty[string]()
+
+	opt.Swap(&a, &b)
+
+	if !a.IsEmpty() {
+		t.Errorf("a after Swap = %v; want <empty>", a)
+	}
+	if !b.HasValue() || b.Value != "a" {
+		t.Errorf("b after Swap = %v; want 'a'", b)
+	}
+}
+
+func TestGetOrInsert(t *testing.T) {
+	option := opt.Empty[int]()
+
+	pointer := option.GetOrInsert(1)
+	if *pointer != 1 || !option.HasValue() || option.Value != 1 {
+		t.Errorf("GetOrInsert() = %d, option = %v; want 1, 1", *pointer, option)
+	}
+
+	*pointer = 2
+	if option.Value != 2 {
+		t.Errorf("option after writing through pointer = %v; want 2", option)
+	}
+
+	if pointer := option.GetOrInsert(3); *pointer != 2 {
+		t.Errorf("GetOrInsert() = %d; want 2 (existing value)", *pointer)
+	}
+}
+
+func TestGetOrInsertWith(t *testing.T) {
+	option := opt.Empty[[]string]()
+
+	pointer := option.GetOrInsertWith(func() []string { return []string{"a"} })
+	*pointer = append(*pointer, "b")
+
+	pointer = option.GetOrInsertWith(func() []string {
+		t.Error("function called on option with value")
+		return nil
+	})
+	if !slices.Equal(*pointer, []string{"a", "b"}) {
+		t.Errorf("GetOrInsertWith() = %v; want [a b]", *pointer)
+	}
+}
+
 func TestValueToPointer(t *testing.T) {
 	option := opt.Value("test")
 	pointer := option.ToPointer()
@@ -128,6 +467,28 @@ func TestEmptyToPointer(t *testing.T) {
 	}
 }
 
+func TestAsPointer(t *testing.T) {
+	option := opt.Value(user{id: 1, name: "before"})
+
+	pointer := option.AsPointer()
+	if pointer == nil {
+		t.Fatal("AsPointer() = nil; want pointer")
+	}
+	pointer.name = "after"
+
+	if option.Value.name != "after" {
+		t.Errorf("option.Value.name = %s; want 'after'", option.Value.name)
+	}
+}
+
+func TestEmptyAsPointer(t *testing.T) {
+	option := opt.Empty[user]()
+
+	if pointer := option.AsPointer(); pointer != nil {
+		t.Errorf("AsPointer() = %v; want nil", pointer)
+	}
+}
+
 func TestZeroValue(t *testing.T) {
 	var option opt.Option[string]
 
@@ -250,3 +611,399 @@ func TestUnmarshalJSON(t *testing.T) {
 		t.Errorf("Field2.Value = %s; want zero value ''", object.Field2.Value)
 	}
 }
+
+func isEven(value int) bool {
+	return value%2 == 0
+}
+
+func TestThen(t *testing.T) {
+	option := opt.Value("  Hermannm ").Then(strings.TrimSpace).Then(strings.ToLower)
+	if !option.HasValue() || option.Value != "hermannm" {
+		t.Errorf("Then() = %v; want 'hermannm'", option)
+	}
+
+	option = opt.Empty[string]().Then(func(value string) string {
+		t.Error("function called on empty option")
+		return value
+	})
+	if !option.IsEmpty() {
+		t.Errorf("Then() = %v; want <empty>", option)
+	}
+}
+
+func validateName(value string) (string, error) {
+	if value == "" {
+		return "", errors.New("name is blank")
+	}
+	return value, nil
+}
+
+func TestThenTry(t *testing.T) {
+	option, err := opt.Value("hermannm").ThenTry(validateName)
+	if err != nil || !option.HasValue() || option.Value != "hermannm" {
+		t.Errorf("ThenTry() = %v, %v; want 'hermannm', nil", option, err)
+	}
+
+	option, err = opt.Value("").ThenTry(validateName)
+	if err == nil || !option.IsEmpty() {
+		t.Errorf("ThenTry() = %v, %v; want <empty>, error", option, err)
+	}
+
+	option, err = opt.Empty[string]().ThenTry(validateName)
+	if err != nil || !option.IsEmpty() {
+		t.Errorf("ThenTry() = %v, %v; want <empty>, nil", option, err)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	if option := opt.Value(2).Filter(isEven); !option.HasValue() || option.Value != 2 {
+		t.Errorf("Filter() = %v; want 2", option)
+	}
+	if option := opt.Value(3).Filter(isEven); !option.IsEmpty() {
+		t.Errorf("Filter() = %v; want <empty>", option)
+	}
+}
+
+func TestFilterEmpty(t *testing.T) {
+	option := opt.Empty[int]().Filter(func(int) bool {
+		t.Error("predicate called on empty option")
+		return true
+	})
+
+	if !option.IsEmpty() {
+		t.Errorf("Filter() = %v; want <empty>", option)
+	}
+}
+
+func TestFilterWithRejectedPassing(t *testing.T) {
+	kept, rejected := opt.Value(2).FilterWithRejected(isEven)
+
+	if !kept.HasValue() {
+		t.Fatal("kept.HasValue: want true")
+	}
+	if kept.Value != 2 {
+		t.Errorf("kept.Value = %d; want 2", kept.Value)
+	}
+	if !rejected.IsEmpty() {
+		t.Error("rejected.IsEmpty: want true")
+	}
+}
+
+func TestFilterWithRejectedFailing(t *testing.T) {
+	kept, rejected := opt.Value(3).FilterWithRejected(isEven)
+
+	if !kept.IsEmpty() {
+		t.Error("kept.IsEmpty: want true")
+	}
+	if !rejected.HasValue() {
+		t.Fatal("rejected.HasValue: want true")
+	}
+	if rejected.Value != 3 {
+		t.Errorf("rejected.Value = %d; want 3", rejected.Value)
+	}
+}
+
+func TestFilterWithRejectedEmpty(t *testing.T) {
+	kept, rejected := opt.Empty[int]().FilterWithRejected(func(int) bool {
+		t.Error("predicate called on empty option")
+		return true
+	})
+
+	if !kept.IsEmpty() {
+		t.Error("kept.IsEmpty: want true")
+	}
+	if !rejected.IsEmpty() {
+		t.Error("rejected.IsEmpty: want true")
+	}
+}
+
+func TestIfPresent(t *testing.T) {
+	var received []string
+	opt.Value("test").IfPresent(func(value string) { received = append(received, value) })
+	opt.Empty[string]().IfPresent(func(value string) { received = append(received, value) })
+
+	if !slices.Equal(received, []string{"test"}) {
+		t.Errorf("IfPresent received %v; want [test]", received)
+	}
+}
+
+func TestIfEmpty(t *testing.T) {
+	calls := 0
+	opt.Value("test").IfEmpty(func() { calls++ })
+	opt.Empty[string]().IfEmpty(func() { calls++ })
+
+	if calls != 1 {
+		t.Errorf("IfEmpty called %d times; want 1", calls)
+	}
+}
+
+func TestInspect(t *testing.T) {
+	var inspected []int
+	option := opt.Value(2).
+		Inspect(func(value int) { inspected = append(inspected, value) }).
+		Filter(isEven).
+		Inspect(func(value int) { inspected = append(inspected, value*10) })
+
+	if !option.HasValue() || option.Value != 2 {
+		t.Errorf("Inspect() = %v; want 2", option)
+	}
+	if !slices.Equal(inspected, []int{2, 20}) {
+		t.Errorf("inspected %v; want [2 20]", inspected)
+	}
+
+	opt.Empty[int]().Inspect(func(int) { t.Error("Inspect called function on empty option") })
+}
+
+func TestDispatchValue(t *testing.T) {
+	var calls []string
+	opt.Value("test").Dispatch(
+		func(value string) { calls = append(calls, "first: "+value) },
+		func(value string) { calls = append(calls, "second: "+value) },
+	)
+
+	expected := []string{"first: test", "second: test"}
+	if !slices.Equal(calls, expected) {
+		t.Errorf("handler calls = %v; want %v", calls, expected)
+	}
+}
+
+func TestDispatchEmpty(t *testing.T) {
+	opt.Empty[string]().Dispatch(
+		func(string) { t.Error("handler called on empty option") },
+		func(string) { t.Error("handler called on empty option") },
+	)
+}
+
+func userColumns(user user) []string {
+	return []string{strconv.Itoa(user.id), user.name}
+}
+
+func TestRowValue(t *testing.T) {
+	rows := opt.Value(user{id: 1, name: "hermannm"}).Row(userColumns)
+
+	if len(rows) != 1 {
+		t.Fatalf("len(Row()) = %d; want 1", len(rows))
+	}
+	expected := []string{"1", "hermannm"}
+	if !slices.Equal(rows[0], expected) {
+		t.Errorf("Row()[0] = %v; want %v", rows[0], expected)
+	}
+}
+
+func TestRowEmpty(t *testing.T) {
+	rows := opt.Empty[user]().Row(userColumns)
+
+	if len(rows) != 0 {
+		t.Errorf("Row() = %v; want no rows", rows)
+	}
+}
+
+func TestToGraphQLValue(t *testing.T) {
+	value, isNull := opt.Value("test").ToGraphQL()
+
+	if isNull {
+		t.Error("isNull = true; want false")
+	}
+	if value != "test" {
+		t.Errorf("value = %v; want 'test'", value)
+	}
+}
+
+func TestToGraphQLEmpty(t *testing.T) {
+	value, isNull := opt.Empty[string]().ToGraphQL()
+
+	if !isNull {
+		t.Error("isNull = false; want true")
+	}
+	if value != nil {
+		t.Errorf("value = %v; want nil", value)
+	}
+}
+
+func TestLoadThroughValue(t *testing.T) {
+	option := opt.Value("cached")
+
+	value, err := option.LoadThrough(func() (string, error) {
+		t.Error("loader called on option with value")
+		return "loaded", nil
+	})
+	if err != nil || value != "cached" {
+		t.Errorf("LoadThrough() = %s, %v; want 'cached', nil", value, err)
+	}
+}
+
+func TestLoadThroughSuccess(t *testing.T) {
+	option := opt.Empty[string]()
+
+	value, err := option.LoadThrough(func() (string, error) { return "loaded", nil })
+	if err != nil || value != "loaded" {
+		t.Errorf("LoadThrough() = %s, %v; want 'loaded', nil", value, err)
+	}
+	if !option.HasValue() || option.Value != "loaded" {
+		t.Errorf("option after LoadThrough = %v; want 'loaded'", option)
+	}
+}
+
+func TestLoadThroughError(t *testing.T) {
+	option := opt.Empty[string]()
+	loadErr := errors.New("load failed")
+
+	value, err := option.LoadThrough(func() (string, error) { return "partial", loadErr })
+	if err != loadErr || value != "" {
+		t.Errorf("LoadThrough() = %s, %v; want '', %v", value, err, loadErr)
+	}
+	if !option.IsEmpty() {
+		t.Errorf("option after LoadThrough = %v; want <empty>", option)
+	}
+}
+
+func TestNormalized(t *testing.T) {
+	option := opt.Empty[int]()
+	option.Value = 5
+
+	if option == opt.Empty[int]() {
+		t.Fatal("empty option with stale value == Empty(); want unequal before normalizing")
+	}
+	if normalized := option.Normalized(); normalized != opt.Empty[int]() {
+		t.Errorf("Normalized() = %+v; want %+v", normalized, opt.Empty[int]())
+	}
+	if normalized := opt.Value(5).Normalized(); normalized != opt.Value(5) {
+		t.Errorf("Normalized() = %v; want 5", normalized)
+	}
+}
+
+func TestUnmarshalNullResetsValue(t *testing.T) {
+	var option opt.Option[int]
+	if err := json.Unmarshal([]byte("5"), &option); err != nil {
+		t.Fatalf("json.Unmarshal error: %v", err)
+	}
+	if err := json.Unmarshal([]byte("null"), &option); err != nil {
+		t.Fatalf("json.Unmarshal error: %v", err)
+	}
+
+	if option != opt.Empty[int]() {
+		t.Errorf("option = %+v; want %+v", option, opt.Empty[int]())
+	}
+
+	counts := map[opt.Option[int]]int{}
+	counts[option]++
+	counts[opt.Empty[int]()]++
+	if len(counts) != 1 {
+		t.Errorf("empty options used as map keys gave %d keys; want 1", len(counts))
+	}
+}
+
+func TestUnmarshalJSONInvalidLeavesOptionUnchanged(t *testing.T) {
+	var option opt.Option[int]
+	if err := json.Unmarshal([]byte(`"x"`), &option); err == nil {
+		t.Fatal("json.Unmarshal: want error")
+	}
+	if option != opt.Empty[int]() {
+		t.Errorf("option = %+v; want %+v", option, opt.Empty[int]())
+	}
+
+	option = opt.Value(5)
+	if err := json.Unmarshal([]byte(`"x"`), &option); err == nil {
+		t.Fatal("json.Unmarshal: want error")
+	}
+	if option != opt.Value(5) {
+		t.Errorf("option = %v; want 5", option)
+	}
+}
+
+func TestEmptyResultsAreNormalized(t *testing.T) {
+	stale := opt.Empty[int]()
+	stale.Value = 5
+	empty := opt.Empty[int]()
+
+	results := map[string]opt.Option[int]{
+		"Then":       stale.Then(func(value int) int { return value }),
+		"Or":         empty.Or(stale),
+		"OrElse":     empty.OrElse(func() opt.Option[int] { return stale }),
+		"And":        opt.Value(1).And(stale),
+		"Inspect":    stale.Inspect(func(int) {}),
+		"Squash":     opt.Squash(stale, nil),
+		"Flatten":    opt.Flatten(opt.Value(stale)),
+		"Min":        opt.Min(stale, stale),
+		"Max":        opt.Max(stale, stale),
+		"Coalesce":   opt.Coalesce(stale, stale),
+		"NonZero":    stale.NonZero(),
+		"Normalized": stale.Normalized(),
+	}
+	thenTry, _ := stale.ThenTry(func(value int) (int, error) { return value, nil })
+	results["ThenTry"] = thenTry
+
+	results["FlatMap"] = opt.FlatMap(opt.Value(1), func(int) opt.Option[int] { return stale })
+	results["NewPipe"] = opt.NewPipe(stale).Option()
+	results["Pipe.AndThen"] = opt.NewPipe(opt.Value(1)).
+		AndThen(func(int) opt.Option[int] { return stale }).
+		Option()
+	results["MergeSlices"] = opt.MergeSlices(
+		[]opt.Option[int]{stale},
+		[]opt.Option[int]{stale},
+		func(a opt.Option[int], b opt.Option[int]) opt.Option[int] { return a },
+	)[0]
+
+	replaced := stale
+	results["Replace"] = replaced.Replace(1)
+
+	var mutex opt.Mutex[int]
+	mutex.Set(stale)
+	results["Mutex.Set"] = mutex.Get()
+	mutex.Do(func(option *opt.Option[int]) { *option = stale })
+	results["Mutex.Do"] = mutex.Get()
+
+	for name, result := range results {
+		if result != empty {
+			t.Errorf("%s() = %+v; want %+v", name, result, empty)
+		}
+	}
+}
+
+type tags struct {
+	values []string
+}
+
+func (original tags) Clone() tags {
+	return tags{values: slices.Clone(original.values)}
+}
+
+type pointerTags struct {
+	values []string
+}
+
+func (original *pointerTags) Clone() pointerTags {
+	return pointerTags{values: slices.Clone(original.values)}
+}
+
+func TestCloneDeep(t *testing.T) {
+	original := opt.Value(tags{values: []string{"a", "b"}})
+	clone := original.Clone()
+
+	clone.Value.values[0] = "changed"
+	if original.Value.values[0] != "a" {
+		t.Errorf("original changed through clone: %v", original.Value.values)
+	}
+}
+
+func TestClonePointerReceiver(t *testing.T) {
+	original := opt.Value(pointerTags{values: []string{"a", "b"}})
+	clone := original.Clone()
+
+	clone.Value.values[0] = "changed"
+	if original.Value.values[0] != "a" {
+		t.Errorf("original changed through clone: %v", original.Value.values)
+	}
+}
+
+func TestCloneShallow(t *testing.T) {
+	original := opt.Value([]string{"a", "b"})
+	clone := original.Clone()
+
+	if !clone.HasValue() || !slices.Equal(clone.Value, original.Value) {
+		t.Errorf("Clone() = %v; want %v", clone, original)
+	}
+	if clone := opt.Empty[tags]().Clone(); !clone.IsEmpty() {
+		t.Errorf("Clone() = %v; want <empty>", clone)
+	}
+}
@@ -0,0 +1,8 @@
+module hermannm.dev/opt/optnull
+
+go 1.23.1
+
+require (
+	github.com/guregu/null/v5 v5.0.0
+	hermannm.dev/opt v0.0.0-20261017013008-9291365e381a
+)
@@ -0,0 +1,38 @@
+// Package optnull provides conversions between [opt.Option] and the nullable types from
+// [github.com/guregu/null/v5]. It lives in its own module, so that the opt package itself does not
+// depend on guregu/null.
+//
+// [github.com/guregu/null/v5]: https://pkg.go.dev/github.com/guregu/null/v5
+package optnull
+
+import (
+	"github.com/guregu/null/v5"
+	"hermannm.dev/opt"
+)
+
+// FromGuregu converts the given [null.Value] to an [opt.Option]. A valid value becomes an option
+// containing the value, and an invalid (null) value becomes an empty option.
+func FromGuregu[T any](value null.Value[T]) opt.Option[T] {
+	return opt.FromSQL(value.Null)
+}
+
+// ToGuregu converts the given [opt.Option] to a [null.Value]. An option with a value becomes a
+// valid value, and an empty option becomes an invalid (null) value.
+func ToGuregu[T any](option opt.Option[T]) null.Value[T] {
+	return null.Value[T]{Null: option.ToSQL()}
+}
+
+// FromGureguString converts the given [null.String] to an [opt.Option], as with [FromGuregu].
+func FromGureguString(value null.String) opt.Option[string] {
+	if value.Valid {
+		return opt.Value(value.String)
+	} else {
+		return opt.Empty[string]()
+	}
+}
+
+// ToGureguString converts the given [opt.Option] to a [null.String], as with [ToGuregu].
+func ToGureguString(option opt.Option[string]) null.String {
+	value, ok := option.Get()
+	return null.NewString(value, ok)
+}
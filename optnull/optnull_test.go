@@ -0,0 +1,51 @@
+package optnull_test
+
+import (
+	"testing"
+
+	"github.com/guregu/null/v5"
+	"hermannm.dev/opt"
+	"hermannm.dev/opt/optnull"
+)
+
+func TestGureguRoundTripValue(t *testing.T) {
+	option := optnull.FromGuregu(null.ValueFrom(5))
+	if !option.HasValue() || option.Value != 5 {
+		t.Fatalf("FromGuregu() = %v; want 5", option)
+	}
+
+	value := optnull.ToGuregu(option)
+	if !value.Valid || value.V != 5 {
+		t.Errorf("ToGuregu() = %v; want valid 5", value)
+	}
+}
+
+func TestGureguRoundTripNull(t *testing.T) {
+	option := optnull.FromGuregu(null.NewValue(5, false))
+	if !option.IsEmpty() {
+		t.Fatalf("FromGuregu() = %v; want <empty>", option)
+	}
+
+	value := optnull.ToGuregu(option)
+	if value.Valid {
+		t.Errorf("ToGuregu() = %v; want invalid", value)
+	}
+}
+
+func TestGureguRoundTripString(t *testing.T) {
+	option := optnull.FromGureguString(null.StringFrom("test"))
+	if !option.HasValue() || option.Value != "test" {
+		t.Fatalf("FromGureguString() = %v; want 'test'", option)
+	}
+	if value := optnull.ToGureguString(option); !value.Valid || value.String != "test" {
+		t.Errorf("ToGureguString() = %v; want valid 'test'", value)
+	}
+
+	empty := optnull.FromGureguString(null.String{})
+	if !empty.IsEmpty() {
+		t.Fatalf("FromGureguString() = %v; want <empty>", empty)
+	}
+	if value := optnull.ToGureguString(opt.Empty[string]()); value.Valid {
+		t.Errorf("ToGureguString() = %v; want invalid", value)
+	}
+}
@@ -0,0 +1,8 @@
+module hermannm.dev/opt/optpb
+
+go 1.23.1
+
+require (
+	google.golang.org/protobuf v1.36.9
+	hermannm.dev/opt v0.0.0-20261017013008-9291365e381a
+)
@@ -0,0 +1,142 @@
+// Package optpb provides conversions between [opt.Option] and the protobuf wrapper types from
+// [google.golang.org/protobuf/types/known/wrapperspb] (such as google.protobuf.StringValue). It
+// lives in its own module, so that the opt package itself does not depend on protobuf.
+//
+// An empty option converts to a nil wrapper message, and an option with a value converts to a
+// wrapper message containing the value.
+package optpb
+
+import (
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"hermannm.dev/opt"
+)
+
+type wrapper[T any] interface {
+	comparable
+	GetValue() T
+}
+
+func fromWrapper[T any, W wrapper[T]](message W) opt.Option[T] {
+	var nilMessage W
+	if message == nilMessage {
+		return opt.Empty[T]()
+	} else {
+		return opt.Value(message.GetValue())
+	}
+}
+
+func toWrapper[T any, W any](option opt.Option[T], newWrapper func(T) *W) *W {
+	if value, ok := option.Get(); ok {
+		return newWrapper(value)
+	} else {
+		return nil
+	}
+}
+
+// FromStringValue converts the given [wrapperspb.StringValue] to an [opt.Option]. A nil message
+// becomes an empty option.
+func FromStringValue(message *wrapperspb.StringValue) opt.Option[string] {
+	return fromWrapper(message)
+}
+
+// ToStringValue converts the given [opt.Option] to a [wrapperspb.StringValue]. An empty option
+// becomes nil.
+func ToStringValue(option opt.Option[string]) *wrapperspb.StringValue {
+	return toWrapper(option, wrapperspb.String)
+}
+
+// FromBytesValue converts the given [wrapperspb.BytesValue] to an [opt.Option]. A nil message
+// becomes an empty option.
+func FromBytesValue(message *wrapperspb.BytesValue) opt.Option[[]byte] {
+	return fromWrapper(message)
+}
+
+// ToBytesValue converts the given [opt.Option] to a [wrapperspb.BytesValue]. An empty option
+// becomes nil.
+func ToBytesValue(option opt.Option[[]byte]) *wrapperspb.BytesValue {
+	return toWrapper(option, wrapperspb.Bytes)
+}
+
+// FromBoolValue converts the given [wrapperspb.BoolValue] to an [opt.Option]. A nil message
+// becomes an empty option.
+func FromBoolValue(message *wrapperspb.BoolValue) opt.Option[bool] {
+	return fromWrapper(message)
+}
+
+// ToBoolValue converts the given [opt.Option] to a [wrapperspb.BoolValue]. An empty option
+// becomes nil.
+func ToBoolValue(option opt.Option[bool]) *wrapperspb.BoolValue {
+	return toWrapper(option, wrapperspb.Bool)
+}
+
+// FromInt32Value converts the given [wrapperspb.Int32Value] to an [opt.Option]. A nil message
+// becomes an empty option.
+func FromInt32Value(message *wrapperspb.Int32Value) opt.Option[int32] {
+	return fromWrapper(message)
+}
+
+// ToInt32Value converts the given [opt.Option] to a [wrapperspb.Int32Value]. An empty option
+// becomes nil.
+func ToInt32Value(option opt.Option[int32]) *wrapperspb.Int32Value {
+	return toWrapper(option, wrapperspb.Int32)
+}
+
+// FromInt64Value converts the given [wrapperspb.Int64Value] to an [opt.Option]. A nil message
+// becomes an empty option.
+func FromInt64Value(message *wrapperspb.Int64Value) opt.Option[int64] {
+	return fromWrapper(message)
+}
+
+// ToInt64Value converts the given [opt.Option] to a [wrapperspb.Int64Value]. An empty option
+// becomes nil.
+func ToInt64Value(option opt.Option[int64]) *wrapperspb.Int64Value {
+	return toWrapper(option, wrapperspb.Int64)
+}
+
+// FromUInt32Value converts the given [wrapperspb.UInt32Value] to an [opt.Option]. A nil message
+// becomes an empty option.
+func FromUInt32Value(message *wrapperspb.UInt32Value) opt.Option[uint32] {
+	return fromWrapper(message)
+}
+
+// ToUInt32Value converts the given [opt.Option] to a [wrapperspb.UInt32Value]. An empty option
+// becomes nil.
+func ToUInt32Value(option opt.Option[uint32]) *wrapperspb.UInt32Value {
+	return toWrapper(option, wrapperspb.UInt32)
+}
+
+// FromUInt64Value converts the given [wrapperspb.UInt64Value] to an [opt.Option]. A nil message
+// becomes an empty option.
+func FromUInt64Value(message *wrapperspb.UInt64Value) opt.Option[uint64] {
+	return fromWrapper(message)
+}
+
+// ToUInt64Value converts the given [opt.Option] to a [wrapperspb.UInt64Value]. An empty option
+// becomes nil.
+func ToUInt64Value(option opt.Option[uint64]) *wrapperspb.UInt64Value {
+	return toWrapper(option, wrapperspb.UInt64)
+}
+
+// FromFloatValue converts the given [wrapperspb.FloatValue] to an [opt.Option]. A nil message
+// becomes an empty option.
+func FromFloatValue(message *wrapperspb.FloatValue) opt.Option[float32] {
+	return fromWrapper(message)
+}
+
+// ToFloatValue converts the given [opt.Option] to a [wrapperspb.FloatValue]. An empty option
+// becomes nil.
+func ToFloatValue(option opt.Option[float32]) *wrapperspb.FloatValue {
+	return toWrapper(option, wrapperspb.Float)
+}
+
+// FromDoubleValue converts the given [wrapperspb.DoubleValue] to an [opt.Option]. A nil message
+// becomes an empty option.
+func FromDoubleValue(message *wrapperspb.DoubleValue) opt.Option[float64] {
+	return fromWrapper(message)
+}
+
+// ToDoubleValue converts the given [opt.Option] to a [wrapperspb.DoubleValue]. An empty option
+// becomes nil.
+func ToDoubleValue(option opt.Option[float64]) *wrapperspb.DoubleValue {
+	return toWrapper(option, wrapperspb.Double)
+}
@@ -0,0 +1,55 @@
+package optpb_test
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"hermannm.dev/opt"
+	"hermannm.dev/opt/optpb"
+)
+
+func TestStringValueNil(t *testing.T) {
+	option := optpb.FromStringValue(nil)
+	if !option.IsEmpty() {
+		t.Fatalf("FromStringValue(nil) = %v; want <empty>", option)
+	}
+
+	if message := optpb.ToStringValue(option); message != nil {
+		t.Errorf("ToStringValue() = %v; want nil", message)
+	}
+}
+
+func TestStringValuePopulated(t *testing.T) {
+	option := optpb.FromStringValue(wrapperspb.String("test"))
+	if !option.HasValue() || option.Value != "test" {
+		t.Fatalf("FromStringValue() = %v; want 'test'", option)
+	}
+
+	message := optpb.ToStringValue(option)
+	if message == nil || message.GetValue() != "test" {
+		t.Errorf("ToStringValue() = %v; want 'test'", message)
+	}
+}
+
+func TestInt32ValueNil(t *testing.T) {
+	option := optpb.FromInt32Value(nil)
+	if !option.IsEmpty() {
+		t.Fatalf("FromInt32Value(nil) = %v; want <empty>", option)
+	}
+
+	if message := optpb.ToInt32Value(opt.Empty[int32]()); message != nil {
+		t.Errorf("ToInt32Value() = %v; want nil", message)
+	}
+}
+
+func TestInt32ValuePopulated(t *testing.T) {
+	option := optpb.FromInt32Value(wrapperspb.Int32(0))
+	if !option.HasValue() || option.Value != 0 {
+		t.Fatalf("FromInt32Value() = %v; want 0", option)
+	}
+
+	message := optpb.ToInt32Value(opt.Value[int32](42))
+	if message == nil || message.GetValue() != 42 {
+		t.Errorf("ToInt32Value() = %v; want 42", message)
+	}
+}
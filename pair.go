@@ -0,0 +1,61 @@
+package opt
+
+// Pair holds two values of possibly different types. It is used by functions that combine or split
+// options, such as [Zip] and [Cut].
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip combines two options into an option containing a pair of their values, if both options have
+// values. If either is empty, an empty option is returned.
+func Zip[A any, B any](a Option[A], b Option[B]) Option[Pair[A, B]] {
+	if a.hasValue && b.hasValue {
+		return Option[Pair[A, B]]{
+			hasValue: true,
+			Value:    Pair[A, B]{First: a.Value, Second: b.Value},
+		}
+	} else {
+		return Option[Pair[A, B]]{hasValue: false}
+	}
+}
+
+// Unzip splits an option containing a pair into two options, one for each element of the pair. If
+// the given option is empty, both returned options are empty.
+func Unzip[A any, B any](option Option[Pair[A, B]]) (Option[A], Option[B]) {
+	if option.hasValue {
+		return Option[A]{hasValue: true, Value: option.Value.First},
+			Option[B]{hasValue: true, Value: option.Value.Second}
+	} else {
+		return Option[A]{hasValue: false}, Option[B]{hasValue: false}
+	}
+}
+
+// CollectPairs returns the pairs from the given slice whose second element is an option with a
+// value, unwrapping those values. The order of the pairs is preserved.
+func CollectPairs[K comparable, V any](pairs []Pair[K, Option[V]]) []Pair[K, V] {
+	var collected []Pair[K, V]
+	for _, pair := range pairs {
+		if pair.Second.hasValue {
+			collected = append(collected, Pair[K, V]{First: pair.First, Second: pair.Second.Value})
+		}
+	}
+	return collected
+}
+
+// ZipSelf returns an option containing a pair of the given option's value and the value derived
+// from it by the given function, or an empty option if the given option is empty (in which case
+// derive is not called). This is useful for keeping both the original value and a computed key.
+//
+// This is a function rather than a method on [Option], since Go methods cannot have their own type
+// parameters.
+func ZipSelf[T any, U any](option Option[T], derive func(value T) U) Option[Pair[T, U]] {
+	if option.hasValue {
+		return Option[Pair[T, U]]{
+			hasValue: true,
+			Value:    Pair[T, U]{First: option.Value, Second: derive(option.Value)},
+		}
+	} else {
+		return Option[Pair[T, U]]{hasValue: false}
+	}
+}
@@ -0,0 +1,79 @@
+package opt_test
+
+import (
+	"slices"
+	"testing"
+
+	"hermannm.dev/opt"
+)
+
+func TestZip(t *testing.T) {
+	option := opt.Zip(opt.Value("page"), opt.Value(2))
+
+	expected := opt.Pair[string, int]{First: "page", Second: 2}
+	if !option.HasValue() || option.Value != expected {
+		t.Errorf("Zip() = %v; want %v", option, expected)
+	}
+}
+
+func TestZipEmpty(t *testing.T) {
+	if option := opt.Zip(opt.Empty[string](), opt.Value(2)); !option.IsEmpty() {
+		t.Errorf("Zip() = %v; want <empty>", option)
+	}
+	if option := opt.Zip(opt.Value("page"), opt.Empty[int]()); !option.IsEmpty() {
+		t.Errorf("Zip() = %v; want <empty>", option)
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	a, b := opt.Unzip(opt.Value(opt.Pair[string, int]{First: "page", Second: 2}))
+	if !a.HasValue() || a.Value != "page" {
+		t.Errorf("Unzip() a = %v; want 'page'", a)
+	}
+	if !b.HasValue() || b.Value != 2 {
+		t.Errorf("Unzip() b = %v; want 2", b)
+	}
+
+	a, b = opt.Unzip(opt.Empty[opt.Pair[string, int]]())
+	if !a.IsEmpty() || !b.IsEmpty() {
+		t.Errorf("Unzip() = %v, %v; want <empty>, <empty>", a, b)
+	}
+}
+
+func TestCollectPairs(t *testing.T) {
+	pairs := []opt.Pair[string, opt.Option[int]]{
+		{First: "c", Second: opt.Value(3)},
+		{First: "a", Second: opt.Empty[int]()},
+		{First: "b", Second: opt.Value(2)},
+		{First: "d", Second: opt.Empty[int]()},
+	}
+	collected := opt.CollectPairs(pairs)
+
+	expected := []opt.Pair[string, int]{{First: "c", Second: 3}, {First: "b", Second: 2}}
+	if !slices.Equal(collected, expected) {
+		t.Errorf("CollectPairs() = %v; want %v", collected, expected)
+	}
+}
+
+func TestZipSelfValue(t *testing.T) {
+	option := opt.ZipSelf(opt.Value("hermannm"), func(value string) int { return len(value) })
+
+	if !option.HasValue() {
+		t.Fatal("HasValue: want true")
+	}
+	expected := opt.Pair[string, int]{First: "hermannm", Second: 8}
+	if option.Value != expected {
+		t.Errorf("Value = %v; want %v", option.Value, expected)
+	}
+}
+
+func TestZipSelfEmpty(t *testing.T) {
+	option := opt.ZipSelf(opt.Empty[string](), func(value string) int {
+		t.Error("derive called on empty option")
+		return len(value)
+	})
+
+	if !option.IsEmpty() {
+		t.Errorf("ZipSelf() = %v; want <empty>", option)
+	}
+}
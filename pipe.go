@@ -0,0 +1,66 @@
+package opt
+
+import (
+	"errors"
+)
+
+// ErrPipeEmpty is returned by [Pipe.Unwrap] when the pipeline ended with an empty option.
+var ErrPipeEmpty = errors.New("option pipeline ended with empty value")
+
+// Pipe wraps an [Option] to allow method chaining of same-type transformations, such as
+// `opt.NewPipe(input).Map(strings.TrimSpace).Filter(isValid).Unwrap()`. Once the pipe becomes
+// empty, the functions passed to later steps are not called.
+//
+// Since Go methods cannot have their own type parameters, the steps cannot change the value's
+// type. Use package-level functions for that.
+type Pipe[T any] struct {
+	option Option[T]
+}
+
+// NewPipe creates a [Pipe] that starts with the given option.
+func NewPipe[T any](option Option[T]) Pipe[T] {
+	return Pipe[T]{option: option.Normalized()}
+}
+
+// Map transforms the pipe's value with the given function, if the pipe has a value.
+func (pipe Pipe[T]) Map(fn func(value T) T) Pipe[T] {
+	if pipe.option.hasValue {
+		return Pipe[T]{option: Option[T]{hasValue: true, Value: fn(pipe.option.Value)}}
+	} else {
+		return pipe
+	}
+}
+
+// Filter empties the pipe if its value does not satisfy the given predicate.
+func (pipe Pipe[T]) Filter(predicate func(value T) bool) Pipe[T] {
+	if pipe.option.hasValue && !predicate(pipe.option.Value) {
+		return Pipe[T]{option: Option[T]{hasValue: false}}
+	} else {
+		return pipe
+	}
+}
+
+// AndThen replaces the pipe's option with the one returned by the given function, if the pipe has a
+// value. This lets a step make the pipe empty.
+func (pipe Pipe[T]) AndThen(fn func(value T) Option[T]) Pipe[T] {
+	if pipe.option.hasValue {
+		return Pipe[T]{option: fn(pipe.option.Value).Normalized()}
+	} else {
+		return pipe
+	}
+}
+
+// Option returns the pipe's current option.
+func (pipe Pipe[T]) Option() Option[T] {
+	return pipe.option
+}
+
+// Unwrap returns the pipe's value, or [ErrPipeEmpty] if the pipe is empty.
+func (pipe Pipe[T]) Unwrap() (T, error) {
+	if pipe.option.hasValue {
+		return pipe.option.Value, nil
+	} else {
+		var zero T
+		return zero, ErrPipeEmpty
+	}
+}
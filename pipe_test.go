@@ -0,0 +1,50 @@
+package opt_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"hermannm.dev/opt"
+)
+
+func isNotBlank(value string) bool {
+	return value != ""
+}
+
+func TestPipeStaysPresent(t *testing.T) {
+	value, err := opt.NewPipe(opt.Value("  Hermannm  ")).
+		Map(strings.TrimSpace).
+		Filter(isNotBlank).
+		AndThen(func(value string) opt.Option[string] { return opt.Value(strings.ToLower(value)) }).
+		Unwrap()
+
+	if err != nil {
+		t.Fatalf("Unwrap() error = %v; want nil", err)
+	}
+	if value != "hermannm" {
+		t.Errorf("Unwrap() value = %s; want 'hermannm'", value)
+	}
+}
+
+func TestPipeEmptiedByFilter(t *testing.T) {
+	pipe := opt.NewPipe(opt.Value("   ")).
+		Map(strings.TrimSpace).
+		Filter(isNotBlank).
+		Map(func(value string) string {
+			t.Error("Map called after pipe became empty")
+			return value
+		})
+
+	if option := pipe.Option(); !option.IsEmpty() {
+		t.Errorf("Option() = %v; want <empty>", option)
+	}
+
+	value, err := pipe.Unwrap()
+	if !errors.Is(err, opt.ErrPipeEmpty) {
+		t.Errorf("Unwrap() error = %v; want %v", err, opt.ErrPipeEmpty)
+	}
+	if value != "" {
+		t.Errorf("Unwrap() value = %s; want zero value ''", value)
+	}
+}
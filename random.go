@@ -0,0 +1,20 @@
+package opt
+
+import (
+	"math/rand/v2"
+)
+
+// Random returns an empty option with the given probability (between 0 and 1), and otherwise an
+// option containing a value from gen. This is useful for generating test fixtures when fuzzing
+// code that consumes options. gen is only called when a value is returned.
+func Random[T any](
+	random *rand.Rand,
+	emptyProbability float64,
+	gen func(random *rand.Rand) T,
+) Option[T] {
+	if random.Float64() < emptyProbability {
+		return Option[T]{hasValue: false}
+	} else {
+		return Option[T]{hasValue: true, Value: gen(random)}
+	}
+}
@@ -0,0 +1,62 @@
+package opt_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"hermannm.dev/opt"
+)
+
+func TestRandomNeverEmpty(t *testing.T) {
+	random := rand.New(rand.NewPCG(1, 2))
+
+	genCalls := 0
+	gen := func(random *rand.Rand) int {
+		genCalls++
+		return random.IntN(100)
+	}
+
+	for range 100 {
+		if option := opt.Random(random, 0, gen); !option.HasValue() {
+			t.Fatal("Random() with emptyProbability 0 returned empty option")
+		}
+	}
+	if genCalls != 100 {
+		t.Errorf("gen called %d times; want 100", genCalls)
+	}
+}
+
+func TestRandomAlwaysEmpty(t *testing.T) {
+	random := rand.New(rand.NewPCG(1, 2))
+
+	gen := func(random *rand.Rand) int {
+		t.Error("gen called for empty option")
+		return 0
+	}
+
+	for range 100 {
+		if option := opt.Random(random, 1, gen); !option.IsEmpty() {
+			t.Fatalf("Random() with emptyProbability 1 = %v; want <empty>", option)
+		}
+	}
+}
+
+func TestRandomGenOnlyForValues(t *testing.T) {
+	random := rand.New(rand.NewPCG(1, 2))
+
+	genCalls := 0
+	gen := func(random *rand.Rand) int {
+		genCalls++
+		return 1
+	}
+
+	present := 0
+	for range 100 {
+		if opt.Random(random, 0.5, gen).HasValue() {
+			present++
+		}
+	}
+	if genCalls != present {
+		t.Errorf("gen called %d times; want %d (number of present options)", genCalls, present)
+	}
+}
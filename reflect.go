@@ -0,0 +1,181 @@
+package opt
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// reflectedOption is implemented by all [Option] types, and lets us work with options of unknown
+// type parameter through reflection.
+type reflectedOption interface {
+	HasValue() bool
+	isOption()
+}
+
+func (option Option[T]) isOption() {}
+
+// settableOption is implemented by pointers to [Option] types, and lets us set the value of options
+// of unknown type parameter through reflection.
+type settableOption interface {
+	reflectedOption
+	valueType() reflect.Type
+	putReflected(value reflect.Value)
+}
+
+func (option *Option[T]) valueType() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+func (option *Option[T]) putReflected(value reflect.Value) {
+	option.Put(value.Interface().(T))
+}
+
+// AnyFieldPresent uses reflection to check the exported [Option] fields of the given struct (or
+// pointer to struct), and returns true if any of them has a value. Fields of other types are
+// ignored. It returns an error if the given value is not a struct or a non-nil pointer to one.
+func AnyFieldPresent(structPtr any) (bool, error) {
+	structValue, err := reflectStruct(structPtr)
+	if err != nil {
+		return false, err
+	}
+
+	for i := range structValue.NumField() {
+		field := structValue.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		option, ok := structValue.Field(i).Interface().(reflectedOption)
+		if ok && option.HasValue() {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func reflectStruct(structPtr any) (reflect.Value, error) {
+	value := reflect.ValueOf(structPtr)
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return reflect.Value{}, fmt.Errorf(
+				"expected struct or pointer to struct, got nil %T",
+				structPtr,
+			)
+		}
+		value = value.Elem()
+	}
+
+	if value.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf(
+			"expected struct or pointer to struct, got %T",
+			structPtr,
+		)
+	}
+
+	return value, nil
+}
+
+// FromReflect creates an [Option] from the given [reflect.Value]. It returns an empty option if
+// the reflect.Value is invalid, holds nil (for pointers, interfaces, maps, slices, channels and
+// functions), cannot be accessed (e.g. obtained through an unexported struct field), or holds a
+// value that is not of type T. Otherwise, it returns an option containing the value.
+func FromReflect[T any](value reflect.Value) Option[T] {
+	if !value.IsValid() || !value.CanInterface() {
+		return Option[T]{hasValue: false}
+	}
+
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+		if value.IsNil() {
+			return Option[T]{hasValue: false}
+		}
+	}
+
+	if typedValue, ok := value.Interface().(T); ok {
+		return Option[T]{hasValue: true, Value: typedValue}
+	} else {
+		return Option[T]{hasValue: false}
+	}
+}
+
+// Defaults uses reflection to fill empty [Option] fields of the given struct pointer with default
+// values from their `default` struct tags. Options that already have values, and fields without a
+// `default` tag, are left as-is. For example:
+//
+//	type Config struct {
+//		Host opt.Option[string] `default:"localhost"`
+//		Port opt.Option[int]    `default:"8080"`
+//	}
+//
+// The tag is parsed according to the option's value type, which must be a string, integer, float or
+// bool type. It returns an error if structPtr is not a non-nil pointer to a struct, if a tag cannot
+// be parsed, or if a tagged option has an unsupported value type.
+func Defaults(structPtr any) error {
+	if reflect.ValueOf(structPtr).Kind() != reflect.Pointer {
+		return fmt.Errorf("expected pointer to struct, got %T", structPtr)
+	}
+	structValue, err := reflectStruct(structPtr)
+	if err != nil {
+		return err
+	}
+
+	for i := range structValue.NumField() {
+		field := structValue.Type().Field(i)
+		defaultTag, hasTag := field.Tag.Lookup("default")
+		if !field.IsExported() || !hasTag {
+			continue
+		}
+
+		option, ok := structValue.Field(i).Addr().Interface().(settableOption)
+		if !ok || option.HasValue() {
+			continue
+		}
+
+		value, err := parseDefaultTag(defaultTag, option.valueType())
+		if err != nil {
+			return fmt.Errorf("invalid default for field '%s': %w", field.Name, err)
+		}
+		option.putReflected(value)
+	}
+
+	return nil
+}
+
+func parseDefaultTag(tag string, valueType reflect.Type) (reflect.Value, error) {
+	value := reflect.New(valueType).Elem()
+
+	switch valueType.Kind() {
+	case reflect.String:
+		value.SetString(tag)
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(tag)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		value.SetBool(parsed)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(tag, 10, valueType.Bits())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		value.SetInt(parsed)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := strconv.ParseUint(tag, 10, valueType.Bits())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		value.SetUint(parsed)
+	case reflect.Float32, reflect.Float64:
+		parsed, err := strconv.ParseFloat(tag, valueType.Bits())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		value.SetFloat(parsed)
+	default:
+		return reflect.Value{}, fmt.Errorf("unsupported option type %s", valueType)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,129 @@
+package opt_test
+
+import (
+	"reflect"
+	"testing"
+
+	"hermannm.dev/opt"
+)
+
+type updateRequest struct {
+	Name     opt.Option[string]
+	Age      opt.Option[int]
+	Internal string
+	hidden   opt.Option[string]
+}
+
+func TestAnyFieldPresentAllEmpty(t *testing.T) {
+	request := updateRequest{Internal: "not an option", hidden: opt.Value("unexported")}
+
+	present, err := opt.AnyFieldPresent(&request)
+	if err != nil {
+		t.Fatalf("AnyFieldPresent error: %v", err)
+	}
+	if present {
+		t.Error("AnyFieldPresent() = true; want false")
+	}
+}
+
+func TestAnyFieldPresentOnePresent(t *testing.T) {
+	request := updateRequest{Age: opt.Value(25)}
+
+	present, err := opt.AnyFieldPresent(&request)
+	if err != nil {
+		t.Fatalf("AnyFieldPresent error: %v", err)
+	}
+	if !present {
+		t.Error("AnyFieldPresent() = false; want true")
+	}
+}
+
+func TestAnyFieldPresentNonStruct(t *testing.T) {
+	value := 5
+
+	if _, err := opt.AnyFieldPresent(&value); err == nil {
+		t.Error("AnyFieldPresent error = nil; want error")
+	}
+	if _, err := opt.AnyFieldPresent((*updateRequest)(nil)); err == nil {
+		t.Error("AnyFieldPresent error = nil; want error for nil pointer")
+	}
+}
+
+func TestFromReflectInvalid(t *testing.T) {
+	if option := opt.FromReflect[string](reflect.Value{}); !option.IsEmpty() {
+		t.Errorf("FromReflect() = %v; want <empty>", option)
+	}
+}
+
+func TestFromReflectNil(t *testing.T) {
+	var pointer *string
+	if option := opt.FromReflect[*string](reflect.ValueOf(pointer)); !option.IsEmpty() {
+		t.Errorf("FromReflect() = %v; want <empty>", option)
+	}
+}
+
+func TestFromReflectMatching(t *testing.T) {
+	option := opt.FromReflect[string](reflect.ValueOf("test"))
+
+	if !option.HasValue() || option.Value != "test" {
+		t.Errorf("FromReflect() = %v; want 'test'", option)
+	}
+}
+
+func TestFromReflectMismatch(t *testing.T) {
+	if option := opt.FromReflect[string](reflect.ValueOf(5)); !option.IsEmpty() {
+		t.Errorf("FromReflect() = %v; want <empty>", option)
+	}
+}
+
+type configWithDefaults struct {
+	Host    opt.Option[string]  `default:"localhost"`
+	Port    opt.Option[int]     `default:"8080"`
+	Debug   opt.Option[bool]    `default:"true"`
+	Ratio   opt.Option[float64] `default:"0.5"`
+	Name    opt.Option[string]
+	Timeout int `default:"30"`
+}
+
+func TestDefaults(t *testing.T) {
+	config := configWithDefaults{Port: opt.Value(3000)}
+
+	if err := opt.Defaults(&config); err != nil {
+		t.Fatalf("Defaults error: %v", err)
+	}
+
+	if !config.Host.HasValue() || config.Host.Value != "localhost" {
+		t.Errorf("Host = %v; want 'localhost'", config.Host)
+	}
+	if !config.Port.HasValue() || config.Port.Value != 3000 {
+		t.Errorf("Port = %v; want 3000 (not overwritten by default)", config.Port)
+	}
+	if !config.Debug.HasValue() || !config.Debug.Value {
+		t.Errorf("Debug = %v; want true", config.Debug)
+	}
+	if !config.Ratio.HasValue() || config.Ratio.Value != 0.5 {
+		t.Errorf("Ratio = %v; want 0.5", config.Ratio)
+	}
+	if !config.Name.IsEmpty() {
+		t.Errorf("Name = %v; want <empty> (no default tag)", config.Name)
+	}
+	if config.Timeout != 0 {
+		t.Errorf("Timeout = %d; want 0 (not an option)", config.Timeout)
+	}
+}
+
+func TestDefaultsInvalidTag(t *testing.T) {
+	var config struct {
+		Port opt.Option[int8] `default:"1000"`
+	}
+
+	if err := opt.Defaults(&config); err == nil {
+		t.Error("Defaults error = nil; want error for overflowing default")
+	}
+}
+
+func TestDefaultsNonPointer(t *testing.T) {
+	if err := opt.Defaults(configWithDefaults{}); err == nil {
+		t.Error("Defaults error = nil; want error for non-pointer")
+	}
+}
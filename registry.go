@@ -0,0 +1,65 @@
+package opt
+
+import (
+	"reflect"
+	"sync"
+)
+
+// DefaultRegistry holds default values keyed by type, for resolving empty options with
+// [Option.OrRegisteredIn]. Register defaults with [Register], and remove them with [Unregister].
+//
+// The zero value is an empty registry ready to use. A DefaultRegistry is safe for concurrent use,
+// and must not be copied after first use.
+type DefaultRegistry struct {
+	// defaults maps from reflect.Type to the default value registered for that type.
+	defaults sync.Map
+}
+
+// globalRegistry is the registry used by [RegisterDefault] and [Option.OrRegistered].
+var globalRegistry DefaultRegistry
+
+// Register registers the given value as the default for its type T in the given registry. Empty
+// options of type T will then resolve to this value in [Option.OrRegisteredIn]. Registering a new
+// default for the same type replaces the previous one.
+func Register[T any](registry *DefaultRegistry, value T) {
+	registry.defaults.Store(reflect.TypeFor[T](), value)
+}
+
+// Unregister removes the default registered for type T in the given registry, if any.
+func Unregister[T any](registry *DefaultRegistry) {
+	registry.defaults.Delete(reflect.TypeFor[T]())
+}
+
+// RegisterDefault registers the given value as the default for its type T in the package-global
+// registry. Empty options of type T will then resolve to this value in [Option.OrRegistered].
+//
+// Since the global registry is shared by the whole program, this is typically called during
+// program initialization. To scope defaults (e.g. in tests), use a [DefaultRegistry] instead.
+func RegisterDefault[T any](value T) {
+	Register(&globalRegistry, value)
+}
+
+// OrRegistered returns the option unchanged if it has a value. If it is empty, it returns an
+// option containing the default registered for T with [RegisterDefault], or an empty option if no
+// default has been registered.
+func (option Option[T]) OrRegistered() Option[T] {
+	return option.OrRegisteredIn(&globalRegistry)
+}
+
+// OrRegisteredIn returns the option unchanged if it has a value. If it is empty, it returns an
+// option containing the default registered for T in the given registry with [Register], or an
+// empty option if no default has been registered.
+func (option Option[T]) OrRegisteredIn(registry *DefaultRegistry) Option[T] {
+	if option.hasValue {
+		return option
+	}
+
+	if stored, ok := registry.defaults.Load(reflect.TypeFor[T]()); ok {
+		// Comma-ok assertion, since a nil default for an interface type T is stored as a nil any,
+		// which a plain assertion would panic on
+		value, _ := stored.(T)
+		return Option[T]{hasValue: true, Value: value}
+	} else {
+		return Option[T]{hasValue: false}
+	}
+}
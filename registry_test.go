@@ -0,0 +1,87 @@
+package opt_test
+
+import (
+	"testing"
+
+	"hermannm.dev/opt"
+)
+
+type port int
+
+func TestOrRegisteredInWithDefault(t *testing.T) {
+	var registry opt.DefaultRegistry
+	opt.Register[port](&registry, 8080)
+
+	option := opt.Empty[port]().OrRegisteredIn(&registry)
+	if !option.HasValue() || option.Value != 8080 {
+		t.Errorf("OrRegisteredIn() = %v; want 8080", option)
+	}
+}
+
+func TestOrRegisteredInWithoutDefault(t *testing.T) {
+	var registry opt.DefaultRegistry
+
+	option := opt.Empty[port]().OrRegisteredIn(&registry)
+	if !option.IsEmpty() {
+		t.Errorf("OrRegisteredIn() = %v; want <empty>", option)
+	}
+}
+
+func TestOrRegisteredInValue(t *testing.T) {
+	var registry opt.DefaultRegistry
+	opt.Register[port](&registry, 8080)
+
+	option := opt.Value[port](3000).OrRegisteredIn(&registry)
+	if !option.HasValue() || option.Value != 3000 {
+		t.Errorf("OrRegisteredIn() = %v; want 3000", option)
+	}
+}
+
+func TestRegistriesAreIsolated(t *testing.T) {
+	var registry1, registry2 opt.DefaultRegistry
+	opt.Register[port](&registry1, 8080)
+
+	if option := opt.Empty[port]().OrRegisteredIn(&registry2); !option.IsEmpty() {
+		t.Errorf("OrRegisteredIn() = %v; want <empty>", option)
+	}
+	if option := opt.Empty[port]().OrRegistered(); !option.IsEmpty() {
+		t.Errorf("OrRegistered() = %v; want <empty>", option)
+	}
+}
+
+func TestUnregister(t *testing.T) {
+	var registry opt.DefaultRegistry
+	opt.Register[port](&registry, 8080)
+	opt.Unregister[port](&registry)
+
+	if option := opt.Empty[port]().OrRegisteredIn(&registry); !option.IsEmpty() {
+		t.Errorf("OrRegisteredIn() = %v; want <empty>", option)
+	}
+}
+
+func TestOrRegisteredInNilInterfaceDefault(t *testing.T) {
+	var registry opt.DefaultRegistry
+	opt.Register[error](&registry, nil)
+
+	option := opt.Empty[error]().OrRegisteredIn(&registry)
+	if !option.HasValue() || option.Value != nil {
+		t.Errorf("OrRegisteredIn() = %v; want present nil error", option)
+	}
+}
+
+// globalPort is only used by TestOrRegistered, since defaults in the global registry are never
+// removed.
+type globalPort int
+
+func TestOrRegistered(t *testing.T) {
+	opt.RegisterDefault[globalPort](8080)
+
+	option := opt.Empty[globalPort]().OrRegistered()
+	if !option.HasValue() || option.Value != 8080 {
+		t.Errorf("OrRegistered() = %v; want 8080", option)
+	}
+	option = opt.Value[globalPort](3000).OrRegistered()
+	if !option.HasValue() || option.Value != 3000 {
+		t.Errorf("OrRegistered() = %v; want 3000", option)
+	}
+}
@@ -0,0 +1,37 @@
+package opt
+
+// Result is a container that either holds a value, or an error. You construct a result with [Ok],
+// [Err] or [ToResult], and access its contents through [Result.Get].
+//
+// The zero value of Result is a successful result holding the zero value of T.
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok creates a successful [Result] that holds the given value.
+func Ok[T any](value T) Result[T] {
+	return Result[T]{value: value, err: nil}
+}
+
+// Err creates a failed [Result] that holds the given error.
+func Err[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// ToResult converts the given option to a [Result]. If the option has a value, a successful result
+// with that value is returned. If the option is empty, a failed result with emptyErr is returned
+// (emptyErr should not be nil, or else the result is indistinguishable from a success).
+func ToResult[T any](option Option[T], emptyErr error) Result[T] {
+	if option.hasValue {
+		return Result[T]{value: option.Value, err: nil}
+	} else {
+		return Result[T]{err: emptyErr}
+	}
+}
+
+// Get returns the value and error of the result. If the error is non-nil, the value is the zero
+// value of T.
+func (result Result[T]) Get() (T, error) {
+	return result.value, result.err
+}
@@ -0,0 +1,43 @@
+package opt_test
+
+import (
+	"errors"
+	"testing"
+
+	"hermannm.dev/opt"
+)
+
+var errEmpty = errors.New("option was empty")
+
+func TestToResultValue(t *testing.T) {
+	result := opt.ToResult(opt.Value("test"), errEmpty)
+	value, err := result.Get()
+
+	if err != nil {
+		t.Errorf("Get() error = %v; want nil", err)
+	}
+	if value != "test" {
+		t.Errorf("Get() value = %s; want 'test'", value)
+	}
+}
+
+func TestToResultEmpty(t *testing.T) {
+	result := opt.ToResult(opt.Empty[string](), errEmpty)
+	value, err := result.Get()
+
+	if !errors.Is(err, errEmpty) {
+		t.Errorf("Get() error = %v; want %v", err, errEmpty)
+	}
+	if value != "" {
+		t.Errorf("Get() value = %s; want zero value ''", value)
+	}
+}
+
+func TestOkAndErr(t *testing.T) {
+	if value, err := opt.Ok(5).Get(); value != 5 || err != nil {
+		t.Errorf("Ok(5).Get() = %d, %v; want 5, nil", value, err)
+	}
+	if value, err := opt.Err[int](errEmpty).Get(); value != 0 || err != errEmpty {
+		t.Errorf("Err().Get() = %d, %v; want 0, %v", value, err, errEmpty)
+	}
+}
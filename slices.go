@@ -0,0 +1,265 @@
+package opt
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// WithIndexDefault returns a slice of the same length as the given options, where each option with
+// a value is replaced by its value, and each empty option is replaced by defaultValue.
+func WithIndexDefault[T any](options []Option[T], defaultValue T) []T {
+	values := make([]T, len(options))
+	for i, option := range options {
+		if option.hasValue {
+			values[i] = option.Value
+		} else {
+			values[i] = defaultValue
+		}
+	}
+	return values
+}
+
+// Front returns an option containing the first element of the given slice, or an empty option if
+// the slice is empty.
+func Front[T any](items []T) Option[T] {
+	if len(items) == 0 {
+		return Option[T]{hasValue: false}
+	}
+	return Option[T]{hasValue: true, Value: items[0]}
+}
+
+// Back returns an option containing the last element of the given slice, or an empty option if the
+// slice is empty.
+func Back[T any](items []T) Option[T] {
+	if len(items) == 0 {
+		return Option[T]{hasValue: false}
+	}
+	return Option[T]{hasValue: true, Value: items[len(items)-1]}
+}
+
+// TakeWhilePresent returns the values of the given options, from the start of the slice up to (but
+// not including) the first empty option.
+func TakeWhilePresent[T any](options []Option[T]) []T {
+	var values []T
+	for _, option := range options {
+		if !option.hasValue {
+			break
+		}
+		values = append(values, option.Value)
+	}
+	return values
+}
+
+// DropWhileEmpty returns the suffix of the given slice that starts at the first option with a
+// value, discarding leading empty options. If all options are empty, an empty slice is returned.
+//
+// The returned slice shares its underlying array with the given slice.
+func DropWhileEmpty[T any](options []Option[T]) []Option[T] {
+	for i, option := range options {
+		if option.hasValue {
+			return options[i:]
+		}
+	}
+	return options[len(options):]
+}
+
+// Coalesce returns the first of the given options that has a value, or an empty option if none of
+// them have values. Like COALESCE in SQL, this is useful for expressing precedence:
+//
+//	port := opt.Coalesce(portFromFlag, portFromEnv, portFromConfigFile)
+func Coalesce[T any](options ...Option[T]) Option[T] {
+	for _, option := range options {
+		if option.hasValue {
+			return option
+		}
+	}
+	return Option[T]{hasValue: false}
+}
+
+// LastPresent returns the last option in the given slice that has a value, or an empty option if
+// none of them have values. It is the counterpart of [Coalesce].
+func LastPresent[T any](options []Option[T]) Option[T] {
+	for i := len(options) - 1; i >= 0; i-- {
+		if options[i].hasValue {
+			return options[i]
+		}
+	}
+	return Option[T]{hasValue: false}
+}
+
+// PresenceMask returns a bitmask where bit i is set if options[i] has a value.
+//
+// It panics if given more than 64 options, since they would not fit in the mask.
+func PresenceMask[T any](options []Option[T]) uint64 {
+	if len(options) > 64 {
+		panic(fmt.Sprintf("opt.PresenceMask: got %d options, max is 64", len(options)))
+	}
+
+	var mask uint64
+	for i, option := range options {
+		if option.hasValue {
+			mask |= 1 << i
+		}
+	}
+	return mask
+}
+
+// BinarySearch searches for target in the given sorted slice, as with [slices.BinarySearch]. If
+// the target is found, it returns an option containing its index. Otherwise, it returns an empty
+// option.
+func BinarySearch[T cmp.Ordered](slice []T, target T) Option[int] {
+	if index, found := slices.BinarySearch(slice, target); found {
+		return Option[int]{hasValue: true, Value: index}
+	} else {
+		return Option[int]{hasValue: false}
+	}
+}
+
+// BinarySearchFunc works like [BinarySearch], but uses the given comparison function, as with
+// [slices.BinarySearchFunc]. This lets you search by a key of a different type than the elements.
+func BinarySearchFunc[T any, E any](slice []T, target E, cmp func(T, E) int) Option[int] {
+	if index, found := slices.BinarySearchFunc(slice, target, cmp); found {
+		return Option[int]{hasValue: true, Value: index}
+	} else {
+		return Option[int]{hasValue: false}
+	}
+}
+
+// Consume checks if the first of the given tokens satisfies match. If it does, it returns an option
+// containing that token, along with the remaining tokens. If it does not (or there are no tokens),
+// it returns an empty option along with the unchanged tokens.
+func Consume[T any](tokens []T, match func(token T) bool) (Option[T], []T) {
+	if len(tokens) == 0 || !match(tokens[0]) {
+		return Option[T]{hasValue: false}, tokens
+	}
+
+	return Option[T]{hasValue: true, Value: tokens[0]}, tokens[1:]
+}
+
+// FormatSlice returns a string representation of the given options that clearly shows which have
+// values, in the format `[Some(1), None, Some(3)]`. This is useful in test failure messages, where
+// the output of [Option.String] can make empty options hard to tell apart from values.
+func FormatSlice[T any](options []Option[T]) string {
+	var builder strings.Builder
+	builder.WriteByte('[')
+	for i, option := range options {
+		if i != 0 {
+			builder.WriteString(", ")
+		}
+
+		if option.hasValue {
+			fmt.Fprintf(&builder, "Some(%v)", option.Value)
+		} else {
+			builder.WriteString("None")
+		}
+	}
+	builder.WriteByte(']')
+	return builder.String()
+}
+
+// FillEmpties returns a copy of the given options, where each empty option at index i is replaced
+// by an option containing fill(i). Options with values are kept as-is.
+func FillEmpties[T any](options []Option[T], fill func(index int) T) []Option[T] {
+	filled := make([]Option[T], len(options))
+	for i, option := range options {
+		if option.hasValue {
+			filled[i] = option
+		} else {
+			filled[i] = Option[T]{hasValue: true, Value: fill(i)}
+		}
+	}
+	return filled
+}
+
+// MergeSlices combines the given option slices element-wise, calling combine with the options at
+// each index. If the slices have different lengths, the result has the length of the shorter one.
+// The combine function decides the presence of each result, e.g. requiring both values or falling
+// back to one of them.
+func MergeSlices[A any, B any, C any](
+	as []Option[A],
+	bs []Option[B],
+	combine func(a Option[A], b Option[B]) Option[C],
+) []Option[C] {
+	merged := make([]Option[C], min(len(as), len(bs)))
+	for i := range merged {
+		merged[i] = combine(as[i], bs[i]).Normalized()
+	}
+	return merged
+}
+
+// FillForward returns a copy of the given options, where each empty option is replaced by the most
+// recent option before it that has a value (also known as "last observation carried forward").
+// Empty options before the first value stay empty.
+func FillForward[T any](options []Option[T]) []Option[T] {
+	filled := make([]Option[T], len(options))
+	last := Option[T]{hasValue: false}
+	for i, option := range options {
+		if option.hasValue {
+			last = option
+		}
+		filled[i] = last
+	}
+	return filled
+}
+
+// FillBackward returns a copy of the given options, where each empty option is replaced by the
+// next option after it that has a value. Empty options after the last value stay empty.
+func FillBackward[T any](options []Option[T]) []Option[T] {
+	filled := make([]Option[T], len(options))
+	next := Option[T]{hasValue: false}
+	for i := len(options) - 1; i >= 0; i-- {
+		if options[i].hasValue {
+			next = options[i]
+		}
+		filled[i] = next
+	}
+	return filled
+}
+
+// DiffSlices compares the given option slices element-wise, and returns the indices where they
+// differ: where one option has a value and the other is empty, or both have different values. If
+// one slice is longer than the other, its extra indices are all counted as changed.
+func DiffSlices[T comparable](oldOptions []Option[T], newOptions []Option[T]) []int {
+	var changed []int
+	for i := range max(len(oldOptions), len(newOptions)) {
+		if i >= len(oldOptions) || i >= len(newOptions) {
+			changed = append(changed, i)
+			continue
+		}
+
+		oldOption, newOption := oldOptions[i], newOptions[i]
+		if oldOption.hasValue != newOption.hasValue ||
+			(oldOption.hasValue && oldOption.Value != newOption.Value) {
+			changed = append(changed, i)
+		}
+	}
+	return changed
+}
+
+// Collect returns an option containing the values of all the given options, if every one of them
+// has a value. If any of them is empty, an empty option is returned. An empty slice of options
+// gives an option containing an empty slice.
+func Collect[T any](options []Option[T]) Option[[]T] {
+	values := make([]T, 0, len(options))
+	for _, option := range options {
+		if !option.hasValue {
+			return Option[[]T]{hasValue: false}
+		}
+		values = append(values, option.Value)
+	}
+	return Option[[]T]{hasValue: true, Value: values}
+}
+
+// Compact returns the values of the given options that have values, skipping empty options. See
+// [CompactSeq] for an iterator version.
+func Compact[T any](options []Option[T]) []T {
+	var values []T
+	for _, option := range options {
+		if option.hasValue {
+			values = append(values, option.Value)
+		}
+	}
+	return values
+}
@@ -0,0 +1,489 @@
+package opt_test
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+
+	"hermannm.dev/opt"
+)
+
+func TestWithIndexDefault(t *testing.T) {
+	options := []opt.Option[string]{
+		opt.Value("a"),
+		opt.Empty[string](),
+		opt.Value("c"),
+		opt.Empty[string](),
+	}
+	values := opt.WithIndexDefault(options, "-")
+
+	expected := []string{"a", "-", "c", "-"}
+	if !slices.Equal(values, expected) {
+		t.Errorf("WithIndexDefault() = %v; want %v", values, expected)
+	}
+}
+
+func TestFrontAndBackEmpty(t *testing.T) {
+	if option := opt.Front([]int{}); !option.IsEmpty() {
+		t.Errorf("Front() = %v; want <empty>", option)
+	}
+	if option := opt.Back([]int(nil)); !option.IsEmpty() {
+		t.Errorf("Back() = %v; want <empty>", option)
+	}
+}
+
+func TestFrontAndBack(t *testing.T) {
+	items := []int{1, 2, 3}
+
+	if option := opt.Front(items); !option.HasValue() || option.Value != 1 {
+		t.Errorf("Front() = %v; want 1", option)
+	}
+	if option := opt.Back(items); !option.HasValue() || option.Value != 3 {
+		t.Errorf("Back() = %v; want 3", option)
+	}
+}
+
+func TestTakeWhilePresentAllPresent(t *testing.T) {
+	options := []opt.Option[int]{opt.Value(1), opt.Value(2), opt.Value(3)}
+	values := opt.TakeWhilePresent(options)
+
+	expected := []int{1, 2, 3}
+	if !slices.Equal(values, expected) {
+		t.Errorf("TakeWhilePresent() = %v; want %v", values, expected)
+	}
+}
+
+func TestTakeWhilePresentEmptyFirst(t *testing.T) {
+	options := []opt.Option[int]{opt.Empty[int](), opt.Value(2)}
+	values := opt.TakeWhilePresent(options)
+
+	if len(values) != 0 {
+		t.Errorf("TakeWhilePresent() = %v; want []", values)
+	}
+}
+
+func TestTakeWhilePresentEmptyInMiddle(t *testing.T) {
+	options := []opt.Option[int]{opt.Value(1), opt.Value(2), opt.Empty[int](), opt.Value(4)}
+	values := opt.TakeWhilePresent(options)
+
+	expected := []int{1, 2}
+	if !slices.Equal(values, expected) {
+		t.Errorf("TakeWhilePresent() = %v; want %v", values, expected)
+	}
+}
+
+func TestDropWhileEmptyAllEmpty(t *testing.T) {
+	options := []opt.Option[int]{opt.Empty[int](), opt.Empty[int]()}
+	result := opt.DropWhileEmpty(options)
+
+	if len(result) != 0 {
+		t.Errorf("DropWhileEmpty() = %v; want []", result)
+	}
+}
+
+func TestDropWhileEmptyPresentFirst(t *testing.T) {
+	options := []opt.Option[int]{opt.Value(1), opt.Empty[int](), opt.Value(3)}
+	result := opt.DropWhileEmpty(options)
+
+	if !slices.Equal(result, options) {
+		t.Errorf("DropWhileEmpty() = %v; want %v", result, options)
+	}
+}
+
+func TestDropWhileEmptyLeadingEmpties(t *testing.T) {
+	options := []opt.Option[int]{opt.Empty[int](), opt.Empty[int](), opt.Value(3), opt.Empty[int]()}
+	result := opt.DropWhileEmpty(options)
+
+	expected := []opt.Option[int]{opt.Value(3), opt.Empty[int]()}
+	if !slices.Equal(result, expected) {
+		t.Errorf("DropWhileEmpty() = %v; want %v", result, expected)
+	}
+}
+
+func TestCoalesce(t *testing.T) {
+	option := opt.Coalesce(opt.Empty[int](), opt.Value(2), opt.Value(3))
+	if !option.HasValue() || option.Value != 2 {
+		t.Errorf("Coalesce() = %v; want 2", option)
+	}
+}
+
+func TestCoalesceAllEmpty(t *testing.T) {
+	if option := opt.Coalesce(opt.Empty[int](), opt.Empty[int]()); !option.IsEmpty() {
+		t.Errorf("Coalesce() = %v; want <empty>", option)
+	}
+	if option := opt.Coalesce[int](); !option.IsEmpty() {
+		t.Errorf("Coalesce() = %v; want <empty>", option)
+	}
+}
+
+func TestLastPresentAllEmpty(t *testing.T) {
+	option := opt.LastPresent([]opt.Option[int]{opt.Empty[int](), opt.Empty[int]()})
+
+	if !option.IsEmpty() {
+		t.Errorf("LastPresent() = %v; want <empty>", option)
+	}
+}
+
+func TestLastPresentAtEnd(t *testing.T) {
+	option := opt.LastPresent([]opt.Option[int]{opt.Empty[int](), opt.Value(2)})
+
+	if !option.HasValue() || option.Value != 2 {
+		t.Errorf("LastPresent() = %v; want 2", option)
+	}
+}
+
+func TestLastPresentMultiple(t *testing.T) {
+	options := []opt.Option[int]{opt.Value(1), opt.Value(2), opt.Empty[int]()}
+	option := opt.LastPresent(options)
+
+	if !option.HasValue() || option.Value != 2 {
+		t.Errorf("LastPresent() = %v; want 2", option)
+	}
+}
+
+func TestPresenceMask(t *testing.T) {
+	options := []opt.Option[int]{opt.Value(0), opt.Empty[int](), opt.Value(2), opt.Value(3)}
+
+	mask := opt.PresenceMask(options)
+	if mask != 0b1101 {
+		t.Errorf("PresenceMask() = %b; want 1101", mask)
+	}
+
+	if mask := opt.PresenceMask([]opt.Option[int]{}); mask != 0 {
+		t.Errorf("PresenceMask() = %b; want 0", mask)
+	}
+}
+
+func TestPresenceMask64(t *testing.T) {
+	options := make([]opt.Option[int], 64)
+	options[0] = opt.Value(0)
+	options[63] = opt.Value(63)
+
+	mask := opt.PresenceMask(options)
+	if mask != 1|1<<63 {
+		t.Errorf("PresenceMask() = %b; want bits 0 and 63 set", mask)
+	}
+}
+
+func TestPresenceMaskOver64(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("PresenceMask: want panic for more than 64 options")
+		}
+	}()
+
+	opt.PresenceMask(make([]opt.Option[int], 65))
+}
+
+type user struct {
+	id   int
+	name string
+}
+
+func compareUserID(user user, id int) int {
+	return cmp.Compare(user.id, id)
+}
+
+func TestBinarySearchFuncFound(t *testing.T) {
+	users := []user{{1, "a"}, {3, "b"}, {5, "c"}}
+	option := opt.BinarySearchFunc(users, 3, compareUserID)
+
+	if !option.HasValue() || option.Value != 1 {
+		t.Errorf("BinarySearchFunc() = %v; want 1", option)
+	}
+}
+
+func TestBinarySearchFuncNotFound(t *testing.T) {
+	users := []user{{1, "a"}, {3, "b"}, {5, "c"}}
+	option := opt.BinarySearchFunc(users, 4, compareUserID)
+
+	if !option.IsEmpty() {
+		t.Errorf("BinarySearchFunc() = %v; want <empty>", option)
+	}
+}
+
+func TestBinarySearchFuncEmptySlice(t *testing.T) {
+	option := opt.BinarySearchFunc([]user{}, 1, compareUserID)
+
+	if !option.IsEmpty() {
+		t.Errorf("BinarySearchFunc() = %v; want <empty>", option)
+	}
+}
+
+func TestBinarySearch(t *testing.T) {
+	if option := opt.BinarySearch([]int{1, 3, 5}, 5); !option.HasValue() || option.Value != 2 {
+		t.Errorf("BinarySearch() = %v; want 2", option)
+	}
+	if option := opt.BinarySearch([]int{1, 3, 5}, 2); !option.IsEmpty() {
+		t.Errorf("BinarySearch() = %v; want <empty>", option)
+	}
+}
+
+func isComma(token string) bool {
+	return token == ","
+}
+
+func TestConsumeMatch(t *testing.T) {
+	tokens := []string{",", "b", "c"}
+	option, rest := opt.Consume(tokens, isComma)
+
+	if !option.HasValue() || option.Value != "," {
+		t.Errorf("Consume() option = %v; want ','", option)
+	}
+	if !slices.Equal(rest, tokens[1:]) {
+		t.Errorf("Consume() rest = %v; want %v", rest, tokens[1:])
+	}
+}
+
+func TestConsumeNoMatch(t *testing.T) {
+	tokens := []string{"a", ",", "c"}
+	option, rest := opt.Consume(tokens, isComma)
+
+	if !option.IsEmpty() {
+		t.Errorf("Consume() option = %v; want <empty>", option)
+	}
+	if !slices.Equal(rest, tokens) {
+		t.Errorf("Consume() rest = %v; want %v", rest, tokens)
+	}
+}
+
+func TestConsumeEmptySlice(t *testing.T) {
+	option, rest := opt.Consume([]string{}, isComma)
+
+	if !option.IsEmpty() {
+		t.Errorf("Consume() option = %v; want <empty>", option)
+	}
+	if len(rest) != 0 {
+		t.Errorf("Consume() rest = %v; want []", rest)
+	}
+}
+
+func TestFormatSliceAllEmpty(t *testing.T) {
+	formatted := opt.FormatSlice([]opt.Option[int]{opt.Empty[int](), opt.Empty[int]()})
+
+	expected := "[None, None]"
+	if formatted != expected {
+		t.Errorf("FormatSlice() = %s; want %s", formatted, expected)
+	}
+}
+
+func TestFormatSliceMixed(t *testing.T) {
+	formatted := opt.FormatSlice([]opt.Option[int]{opt.Value(1), opt.Empty[int](), opt.Value(3)})
+
+	expected := "[Some(1), None, Some(3)]"
+	if formatted != expected {
+		t.Errorf("FormatSlice() = %s; want %s", formatted, expected)
+	}
+}
+
+func TestFormatSliceEmptySlice(t *testing.T) {
+	formatted := opt.FormatSlice([]opt.Option[int]{})
+
+	expected := "[]"
+	if formatted != expected {
+		t.Errorf("FormatSlice() = %s; want %s", formatted, expected)
+	}
+}
+
+func TestFillEmpties(t *testing.T) {
+	options := []opt.Option[int]{opt.Empty[int](), opt.Value(1), opt.Empty[int](), opt.Value(3)}
+
+	var filledIndices []int
+	filled := opt.FillEmpties(options, func(index int) int {
+		filledIndices = append(filledIndices, index)
+		return index * 10
+	})
+
+	expected := []opt.Option[int]{opt.Value(0), opt.Value(1), opt.Value(20), opt.Value(3)}
+	if !slices.Equal(filled, expected) {
+		t.Errorf("FillEmpties() = %s; want %s", opt.FormatSlice(filled), opt.FormatSlice(expected))
+	}
+	if !slices.Equal(filledIndices, []int{0, 2}) {
+		t.Errorf("fill called with indices %v; want [0 2]", filledIndices)
+	}
+	if !options[0].IsEmpty() {
+		t.Error("FillEmpties modified the input slice")
+	}
+}
+
+func sumOrFirst(a opt.Option[int], b opt.Option[int]) opt.Option[int] {
+	if a.IsEmpty() {
+		return opt.Empty[int]()
+	}
+	return opt.Value(a.Value + b.GetOrDefault(0))
+}
+
+func TestMergeSlicesEqualLength(t *testing.T) {
+	as := []opt.Option[int]{opt.Value(1), opt.Value(2), opt.Empty[int](), opt.Empty[int]()}
+	bs := []opt.Option[int]{opt.Value(10), opt.Empty[int](), opt.Value(30), opt.Empty[int]()}
+	merged := opt.MergeSlices(as, bs, sumOrFirst)
+
+	expected := []opt.Option[int]{opt.Value(11), opt.Value(2), opt.Empty[int](), opt.Empty[int]()}
+	if !slices.Equal(merged, expected) {
+		t.Errorf("MergeSlices() = %s; want %s", opt.FormatSlice(merged), opt.FormatSlice(expected))
+	}
+}
+
+func TestMergeSlicesDifferentLengths(t *testing.T) {
+	as := []opt.Option[int]{opt.Value(1), opt.Value(2), opt.Value(3)}
+	bs := []opt.Option[int]{opt.Value(10)}
+
+	merged := opt.MergeSlices(as, bs, sumOrFirst)
+	expected := []opt.Option[int]{opt.Value(11)}
+	if !slices.Equal(merged, expected) {
+		t.Errorf("MergeSlices() = %s; want %s", opt.FormatSlice(merged), opt.FormatSlice(expected))
+	}
+
+	merged = opt.MergeSlices(bs, as, sumOrFirst)
+	if !slices.Equal(merged, expected) {
+		t.Errorf("MergeSlices() = %s; want %s", opt.FormatSlice(merged), opt.FormatSlice(expected))
+	}
+}
+
+func TestFillForwardLeadingEmpties(t *testing.T) {
+	options := []opt.Option[int]{opt.Empty[int](), opt.Empty[int](), opt.Value(3)}
+	filled := opt.FillForward(options)
+
+	expected := []opt.Option[int]{opt.Empty[int](), opt.Empty[int](), opt.Value(3)}
+	if !slices.Equal(filled, expected) {
+		t.Errorf("FillForward() = %s; want %s", opt.FormatSlice(filled), opt.FormatSlice(expected))
+	}
+}
+
+func TestFillForwardInteriorGaps(t *testing.T) {
+	options := []opt.Option[int]{
+		opt.Value(1),
+		opt.Empty[int](),
+		opt.Empty[int](),
+		opt.Value(4),
+		opt.Empty[int](),
+	}
+	filled := opt.FillForward(options)
+
+	expected := []opt.Option[int]{
+		opt.Value(1),
+		opt.Value(1),
+		opt.Value(1),
+		opt.Value(4),
+		opt.Value(4),
+	}
+	if !slices.Equal(filled, expected) {
+		t.Errorf("FillForward() = %s; want %s", opt.FormatSlice(filled), opt.FormatSlice(expected))
+	}
+}
+
+func TestFillForwardAllPresent(t *testing.T) {
+	options := []opt.Option[int]{opt.Value(1), opt.Value(2), opt.Value(3)}
+	filled := opt.FillForward(options)
+
+	if !slices.Equal(filled, options) {
+		t.Errorf("FillForward() = %s; want %s", opt.FormatSlice(filled), opt.FormatSlice(options))
+	}
+}
+
+func TestFillBackwardTrailingEmpties(t *testing.T) {
+	options := []opt.Option[int]{opt.Value(1), opt.Empty[int](), opt.Empty[int]()}
+	filled := opt.FillBackward(options)
+
+	expected := []opt.Option[int]{opt.Value(1), opt.Empty[int](), opt.Empty[int]()}
+	if !slices.Equal(filled, expected) {
+		t.Errorf("FillBackward() = %s; want %s", opt.FormatSlice(filled), opt.FormatSlice(expected))
+	}
+}
+
+func TestFillBackwardInteriorGaps(t *testing.T) {
+	options := []opt.Option[int]{
+		opt.Empty[int](),
+		opt.Value(2),
+		opt.Empty[int](),
+		opt.Empty[int](),
+		opt.Value(5),
+	}
+	filled := opt.FillBackward(options)
+
+	expected := []opt.Option[int]{
+		opt.Value(2),
+		opt.Value(2),
+		opt.Value(5),
+		opt.Value(5),
+		opt.Value(5),
+	}
+	if !slices.Equal(filled, expected) {
+		t.Errorf("FillBackward() = %s; want %s", opt.FormatSlice(filled), opt.FormatSlice(expected))
+	}
+}
+
+func TestFillBackwardAllEmpty(t *testing.T) {
+	options := []opt.Option[int]{opt.Empty[int](), opt.Empty[int]()}
+	filled := opt.FillBackward(options)
+
+	if !slices.Equal(filled, options) {
+		t.Errorf("FillBackward() = %s; want %s", opt.FormatSlice(filled), opt.FormatSlice(options))
+	}
+}
+
+func TestDiffSlicesEqual(t *testing.T) {
+	options := []opt.Option[int]{opt.Value(1), opt.Empty[int](), opt.Value(3)}
+
+	if changed := opt.DiffSlices(options, slices.Clone(options)); len(changed) != 0 {
+		t.Errorf("DiffSlices() = %v; want []", changed)
+	}
+}
+
+func TestDiffSlicesChanges(t *testing.T) {
+	oldOptions := []opt.Option[int]{opt.Value(1), opt.Empty[int](), opt.Value(3), opt.Value(4)}
+	newOptions := []opt.Option[int]{opt.Value(1), opt.Value(2), opt.Value(30), opt.Value(4)}
+
+	changed := opt.DiffSlices(oldOptions, newOptions)
+	expected := []int{1, 2}
+	if !slices.Equal(changed, expected) {
+		t.Errorf("DiffSlices() = %v; want %v", changed, expected)
+	}
+}
+
+func TestDiffSlicesDifferentLengths(t *testing.T) {
+	shorter := []opt.Option[int]{opt.Value(1)}
+	longer := []opt.Option[int]{opt.Value(1), opt.Empty[int](), opt.Value(3)}
+
+	expected := []int{1, 2}
+	if changed := opt.DiffSlices(shorter, longer); !slices.Equal(changed, expected) {
+		t.Errorf("DiffSlices() = %v; want %v", changed, expected)
+	}
+	if changed := opt.DiffSlices(longer, shorter); !slices.Equal(changed, expected) {
+		t.Errorf("DiffSlices() = %v; want %v", changed, expected)
+	}
+}
+
+func TestCollectAllPresent(t *testing.T) {
+	option := opt.Collect([]opt.Option[int]{opt.Value(1), opt.Value(2), opt.Value(3)})
+
+	if !option.HasValue() || !slices.Equal(option.Value, []int{1, 2, 3}) {
+		t.Errorf("Collect() = %v; want [1 2 3]", option)
+	}
+}
+
+func TestCollectOneEmpty(t *testing.T) {
+	option := opt.Collect([]opt.Option[int]{opt.Value(1), opt.Empty[int](), opt.Value(3)})
+
+	if !option.IsEmpty() {
+		t.Errorf("Collect() = %v; want <empty>", option)
+	}
+}
+
+func TestCollectEmptySlice(t *testing.T) {
+	option := opt.Collect([]opt.Option[int]{})
+
+	if !option.HasValue() || len(option.Value) != 0 {
+		t.Errorf("Collect() = %v; want []", option)
+	}
+}
+
+func TestCompact(t *testing.T) {
+	options := []opt.Option[int]{opt.Empty[int](), opt.Value(1), opt.Empty[int](), opt.Value(3)}
+	values := opt.Compact(options)
+
+	expected := []int{1, 3}
+	if !slices.Equal(values, expected) {
+		t.Errorf("Compact() = %v; want %v", values, expected)
+	}
+}
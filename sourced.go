@@ -0,0 +1,50 @@
+package opt
+
+// Sourced is an optional value that also records where the value came from (e.g. "flag", "env" or
+// "config file"). This helps debugging config precedence: when choosing between several sources
+// with [Sourced.Or], the source of the winning value is kept.
+//
+// You construct a Sourced with a value using [ValueFrom]. The zero value of Sourced is empty, with
+// a blank source.
+type Sourced[T any] struct {
+	hasValue bool
+	// Before accessing Value, you should check if it is present with [Sourced.HasValue].
+	Value T
+	// Source describes where the value came from. It is blank for an empty Sourced.
+	Source string
+}
+
+// ValueFrom creates a [Sourced] that contains the given value, from the given source.
+func ValueFrom[T any](value T, source string) Sourced[T] {
+	return Sourced[T]{hasValue: true, Value: value, Source: source}
+}
+
+// HasValue returns true if the Sourced contains a value.
+func (sourced Sourced[T]) HasValue() bool {
+	return sourced.hasValue
+}
+
+// Get returns the value, the source it came from, and an `ok` flag that is true if a value was
+// present. You should only use the returned value and source if `ok` is true.
+func (sourced Sourced[T]) Get() (value T, source string, ok bool) {
+	return sourced.Value, sourced.Source, sourced.hasValue
+}
+
+// Or returns the receiver if it has a value, or else the given fallback. Chain calls to express
+// precedence, e.g. `fromFlag.Or(fromEnv).Or(fromFile)`.
+func (sourced Sourced[T]) Or(fallback Sourced[T]) Sourced[T] {
+	if sourced.hasValue {
+		return sourced
+	} else {
+		return fallback
+	}
+}
+
+// Option converts the Sourced to an [Option], discarding the source.
+func (sourced Sourced[T]) Option() Option[T] {
+	if sourced.hasValue {
+		return Option[T]{hasValue: true, Value: sourced.Value}
+	} else {
+		return Option[T]{hasValue: false}
+	}
+}
@@ -0,0 +1,42 @@
+package opt_test
+
+import (
+	"testing"
+
+	"hermannm.dev/opt"
+)
+
+func TestValueFrom(t *testing.T) {
+	sourced := opt.ValueFrom(8080, "env")
+
+	value, source, ok := sourced.Get()
+	if !ok || value != 8080 || source != "env" {
+		t.Errorf("Get() = %d, %s, %t; want 8080, env, true", value, source, ok)
+	}
+}
+
+func TestSourcedOrChain(t *testing.T) {
+	var fromFlag opt.Sourced[int]
+	fromEnv := opt.ValueFrom(3000, "env")
+	fromFile := opt.ValueFrom(8080, "config file")
+
+	value, source, ok := fromFlag.Or(fromEnv).Or(fromFile).Get()
+	if !ok || value != 3000 || source != "env" {
+		t.Errorf("Get() = %d, %s, %t; want 3000, env, true", value, source, ok)
+	}
+
+	if option := fromFlag.Or(fromEnv).Option(); !option.HasValue() || option.Value != 3000 {
+		t.Errorf("Option() = %v; want 3000", option)
+	}
+}
+
+func TestSourcedEmpty(t *testing.T) {
+	var sourced opt.Sourced[int]
+
+	if sourced.HasValue() {
+		t.Error("HasValue: want false")
+	}
+	if option := sourced.Option(); !option.IsEmpty() {
+		t.Errorf("Option() = %v; want <empty>", option)
+	}
+}
@@ -0,0 +1,102 @@
+package opt
+
+import (
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FromSQLMap converts each [sql.Null] value in the given map to an [Option], as with [FromSQL].
+// Null SQL values become empty options.
+func FromSQLMap[K comparable, V any](sqlValues map[K]sql.Null[V]) map[K]Option[V] {
+	options := make(map[K]Option[V], len(sqlValues))
+	for key, sqlValue := range sqlValues {
+		options[key] = FromSQL(sqlValue)
+	}
+	return options
+}
+
+// SQLLiteral returns the option's value formatted as an SQL literal, or `NULL` if the option is
+// empty. The following value types are supported:
+//   - Strings are enclosed in single quotes, with embedded single quotes escaped by doubling them
+//   - Byte slices become hex blob literals, e.g. `X'6869'`
+//   - Booleans become `TRUE` or `FALSE`
+//   - Integers and floats are formatted as-is
+//   - [time.Time] values become quoted timestamps, e.g. `'2024-01-02 15:04:05+01:00'`
+//
+// It panics if the value has any other type (since there is no portable way to format it), or if
+// it is a NaN or infinite float (since SQL has no literals for these).
+//
+// This is meant for generating SQL scripts. When running queries, you should use query parameters
+// instead (see [Option.ToSQL]). Do not use this with untrusted input: string escaping rules vary
+// between databases, so this is not a safe defense against SQL injection.
+func (option Option[T]) SQLLiteral() string {
+	if !option.hasValue {
+		return "NULL"
+	}
+
+	if timestamp, ok := any(option.Value).(time.Time); ok {
+		return quoteSQLString(timestamp.Format("2006-01-02 15:04:05.999999999Z07:00"))
+	}
+
+	value := reflect.ValueOf(option.Value)
+	switch value.Kind() {
+	case reflect.String:
+		return quoteSQLString(value.String())
+	case reflect.Bool:
+		if value.Bool() {
+			return "TRUE"
+		} else {
+			return "FALSE"
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Uintptr:
+		return strconv.FormatUint(value.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		float := value.Float()
+		if math.IsNaN(float) || math.IsInf(float, 0) {
+			panic(fmt.Sprintf("opt.SQLLiteral: %v has no SQL literal", float))
+		}
+		return strconv.FormatFloat(float, 'g', -1, value.Type().Bits())
+	case reflect.Slice:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return "X'" + strings.ToUpper(hex.EncodeToString(value.Bytes())) + "'"
+		}
+	}
+
+	panic(fmt.Sprintf("opt.SQLLiteral: unsupported value type %T", option.Value))
+}
+
+func quoteSQLString(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+// FromColumns creates an [Option] from a value and a "valid" flag, stored in separate columns (for
+// example, a value column and a boolean "has value" column in a database table). If valid is false,
+// an empty option is returned, and the value is ignored.
+//
+// It is the same as [FromOk], named for readability in database code. The inverse is
+// [Option.ToColumns].
+func FromColumns[T any](value T, valid bool) Option[T] {
+	return FromOk(value, valid)
+}
+
+// ToColumns returns the option's value and a "valid" flag, for storing in separate columns. It is
+// the inverse of [FromColumns]. It works like [Option.Get], except that the value is always the
+// zero value of T if the option is empty (even if the Value field was set directly), so that no
+// stale value is stored.
+func (option Option[T]) ToColumns() (value T, valid bool) {
+	if option.hasValue {
+		return option.Value, true
+	} else {
+		var zero T
+		return zero, false
+	}
+}
@@ -0,0 +1,141 @@
+package opt_test
+
+import (
+	"database/sql"
+	"math"
+	"testing"
+	"time"
+
+	"hermannm.dev/opt"
+)
+
+func TestFromSQLMap(t *testing.T) {
+	sqlValues := map[string]sql.Null[int]{
+		"a": {Valid: true, V: 1},
+		"b": {Valid: false},
+		"c": {Valid: true, V: 0},
+	}
+	options := opt.FromSQLMap(sqlValues)
+
+	if len(options) != len(sqlValues) {
+		t.Fatalf("len(FromSQLMap()) = %d; want %d", len(options), len(sqlValues))
+	}
+	if option := options["a"]; !option.HasValue() || option.Value != 1 {
+		t.Errorf("options[a] = %v; want 1", option)
+	}
+	if option := options["b"]; !option.IsEmpty() {
+		t.Errorf("options[b] = %v; want <empty>", option)
+	}
+	if option := options["c"]; !option.HasValue() || option.Value != 0 {
+		t.Errorf("options[c] = %v; want 0", option)
+	}
+}
+
+func TestSQLLiteralNull(t *testing.T) {
+	if literal := opt.Empty[string]().SQLLiteral(); literal != "NULL" {
+		t.Errorf("SQLLiteral() = %s; want NULL", literal)
+	}
+}
+
+func TestSQLLiteralString(t *testing.T) {
+	literal := opt.Value("O'Brien").SQLLiteral()
+
+	expected := "'O''Brien'"
+	if literal != expected {
+		t.Errorf("SQLLiteral() = %s; want %s", literal, expected)
+	}
+}
+
+func TestSQLLiteralNumber(t *testing.T) {
+	if literal := opt.Value(-42).SQLLiteral(); literal != "-42" {
+		t.Errorf("SQLLiteral() = %s; want -42", literal)
+	}
+	if literal := opt.Value(1.5).SQLLiteral(); literal != "1.5" {
+		t.Errorf("SQLLiteral() = %s; want 1.5", literal)
+	}
+}
+
+func TestSQLLiteralBool(t *testing.T) {
+	if literal := opt.Value(true).SQLLiteral(); literal != "TRUE" {
+		t.Errorf("SQLLiteral() = %s; want TRUE", literal)
+	}
+	if literal := opt.Value(false).SQLLiteral(); literal != "FALSE" {
+		t.Errorf("SQLLiteral() = %s; want FALSE", literal)
+	}
+}
+
+func TestSQLLiteralBytes(t *testing.T) {
+	literal := opt.Value([]byte("hi")).SQLLiteral()
+
+	expected := "X'6869'"
+	if literal != expected {
+		t.Errorf("SQLLiteral() = %s; want %s", literal, expected)
+	}
+}
+
+func TestSQLLiteralTime(t *testing.T) {
+	timestamp := time.Date(2024, 1, 2, 15, 4, 5, 0, time.FixedZone("", 60*60))
+	literal := opt.Value(timestamp).SQLLiteral()
+
+	expected := "'2024-01-02 15:04:05+01:00'"
+	if literal != expected {
+		t.Errorf("SQLLiteral() = %s; want %s", literal, expected)
+	}
+}
+
+func TestSQLLiteralNonFiniteFloatPanics(t *testing.T) {
+	for _, value := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		assertSQLLiteralPanics(t, opt.Value(value))
+	}
+}
+
+func TestSQLLiteralUnsupportedTypePanics(t *testing.T) {
+	value := 5
+	assertSQLLiteralPanics(t, opt.Value(&value))
+	assertSQLLiteralPanics(t, opt.Value(struct{ Name string }{Name: "test"}))
+}
+
+func assertSQLLiteralPanics[T any](t *testing.T, option opt.Option[T]) {
+	t.Helper()
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("SQLLiteral: want panic for %v", option)
+		}
+	}()
+
+	option.SQLLiteral()
+}
+
+func TestColumnsRoundTripValue(t *testing.T) {
+	value, valid := opt.Value("test").ToColumns()
+	if value != "test" || !valid {
+		t.Fatalf("ToColumns() = %s, %t; want 'test', true", value, valid)
+	}
+
+	option := opt.FromColumns(value, valid)
+	if !option.HasValue() || option.Value != "test" {
+		t.Errorf("FromColumns() = %v; want 'test'", option)
+	}
+}
+
+func TestToColumnsZeroesStaleValue(t *testing.T) {
+	option := opt.Empty[string]()
+	option.Value = "stale"
+
+	if value, valid := option.ToColumns(); value != "" || valid {
+		t.Errorf("ToColumns() = %s, %t; want '', false", value, valid)
+	}
+}
+
+func TestColumnsRoundTripEmpty(t *testing.T) {
+	value, valid := opt.Empty[string]().ToColumns()
+	if value != "" || valid {
+		t.Fatalf("ToColumns() = %s, %t; want '', false", value, valid)
+	}
+
+	option := opt.FromColumns("ignored", valid)
+	if !option.IsEmpty() {
+		t.Errorf("FromColumns() = %v; want <empty>", option)
+	}
+}
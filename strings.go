@@ -0,0 +1,58 @@
+package opt
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Cut slices the given string around the first instance of sep, like [strings.Cut]. If sep is
+// found, it returns an option containing the text before and after sep (as [Pair.First] and
+// [Pair.Second]). If sep is not found, an empty option is returned.
+func Cut(s string, sep string) Option[Pair[string, string]] {
+	before, after, found := strings.Cut(s, sep)
+	if found {
+		return Option[Pair[string, string]]{
+			hasValue: true,
+			Value:    Pair[string, string]{First: before, Second: after},
+		}
+	} else {
+		return Option[Pair[string, string]]{hasValue: false}
+	}
+}
+
+// ConvertString converts the value of the given option between string and byte slice types (e.g.
+// Option[[]byte] to Option[string], or between named string types), using a regular Go type
+// conversion. An empty option returns an empty option.
+func ConvertString[From ~string | ~[]byte, To ~string | ~[]byte](option Option[From]) Option[To] {
+	if option.hasValue {
+		return Option[To]{hasValue: true, Value: To(option.Value)}
+	} else {
+		return Option[To]{hasValue: false}
+	}
+}
+
+// StringToBytes converts an option containing a string to an option containing the string's bytes.
+// An empty option returns an empty option.
+//
+// The bytes are copied, so the returned slice is safe to modify. There is no zero-copy variant,
+// since modifying bytes that share memory with a string would break Go's guarantee that strings
+// are immutable.
+func StringToBytes(option Option[string]) Option[[]byte] {
+	if option.hasValue {
+		return Option[[]byte]{hasValue: true, Value: []byte(option.Value)}
+	} else {
+		return Option[[]byte]{hasValue: false}
+	}
+}
+
+// ParseIntBase parses the given string as an integer in the given base and bit size, as with
+// [strconv.ParseInt]. If parsing succeeds, it returns an option containing the parsed value. If
+// the string is not a valid integer, or the value overflows the bit size, an empty option is
+// returned.
+func ParseIntBase(s string, base int, bitSize int) Option[int64] {
+	if value, err := strconv.ParseInt(s, base, bitSize); err == nil {
+		return Option[int64]{hasValue: true, Value: value}
+	} else {
+		return Option[int64]{hasValue: false}
+	}
+}
@@ -0,0 +1,118 @@
+package opt_test
+
+import (
+	"testing"
+
+	"hermannm.dev/opt"
+)
+
+func TestCutFound(t *testing.T) {
+	option := opt.Cut("key=value", "=")
+
+	if !option.HasValue() {
+		t.Fatal("HasValue: want true")
+	}
+	if option.Value.First != "key" {
+		t.Errorf("Value.First = %s; want 'key'", option.Value.First)
+	}
+	if option.Value.Second != "value" {
+		t.Errorf("Value.Second = %s; want 'value'", option.Value.Second)
+	}
+}
+
+func TestCutNotFound(t *testing.T) {
+	option := opt.Cut("key", "=")
+
+	if !option.IsEmpty() {
+		t.Errorf("IsEmpty: want true (got value %v)", option.Value)
+	}
+}
+
+func TestCutAtStart(t *testing.T) {
+	option := opt.Cut("=value", "=")
+
+	if !option.HasValue() {
+		t.Fatal("HasValue: want true")
+	}
+	if option.Value.First != "" {
+		t.Errorf("Value.First = %s; want ''", option.Value.First)
+	}
+	if option.Value.Second != "value" {
+		t.Errorf("Value.Second = %s; want 'value'", option.Value.Second)
+	}
+}
+
+func TestCutAtEnd(t *testing.T) {
+	option := opt.Cut("key=", "=")
+
+	if !option.HasValue() {
+		t.Fatal("HasValue: want true")
+	}
+	if option.Value.First != "key" {
+		t.Errorf("Value.First = %s; want 'key'", option.Value.First)
+	}
+	if option.Value.Second != "" {
+		t.Errorf("Value.Second = %s; want ''", option.Value.Second)
+	}
+}
+
+type userID string
+
+func TestConvertString(t *testing.T) {
+	option := opt.ConvertString[[]byte, string](opt.Value([]byte("test")))
+	if !option.HasValue() || option.Value != "test" {
+		t.Errorf("ConvertString() = %v; want 'test'", option)
+	}
+
+	id := opt.ConvertString[string, userID](opt.Value("user-1"))
+	if !id.HasValue() || id.Value != userID("user-1") {
+		t.Errorf("ConvertString() = %v; want 'user-1'", id)
+	}
+
+	if option := opt.ConvertString[[]byte, string](opt.Empty[[]byte]()); !option.IsEmpty() {
+		t.Errorf("ConvertString() = %v; want <empty>", option)
+	}
+}
+
+func TestStringToBytesValue(t *testing.T) {
+	option := opt.StringToBytes(opt.Value("test"))
+
+	if !option.HasValue() {
+		t.Fatal("HasValue: want true")
+	}
+	if string(option.Value) != "test" {
+		t.Errorf("Value = %q; want 'test'", option.Value)
+	}
+}
+
+func TestStringToBytesEmpty(t *testing.T) {
+	option := opt.StringToBytes(opt.Empty[string]())
+
+	if !option.IsEmpty() {
+		t.Errorf("StringToBytes() = %v; want <empty>", option)
+	}
+}
+
+func TestParseIntBaseDecimal(t *testing.T) {
+	if option := opt.ParseIntBase("-123", 10, 64); !option.HasValue() || option.Value != -123 {
+		t.Errorf("ParseIntBase() = %v; want -123", option)
+	}
+}
+
+func TestParseIntBaseHex(t *testing.T) {
+	if option := opt.ParseIntBase("ff", 16, 64); !option.HasValue() || option.Value != 255 {
+		t.Errorf("ParseIntBase() = %v; want 255", option)
+	}
+}
+
+func TestParseIntBaseInvalid(t *testing.T) {
+	if option := opt.ParseIntBase("12x", 10, 64); !option.IsEmpty() {
+		t.Errorf("ParseIntBase() = %v; want <empty>", option)
+	}
+}
+
+func TestParseIntBaseOverflow(t *testing.T) {
+	if option := opt.ParseIntBase("128", 10, 8); !option.IsEmpty() {
+		t.Errorf("ParseIntBase() = %v; want <empty>", option)
+	}
+}
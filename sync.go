@@ -0,0 +1,86 @@
+package opt
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Mutex is an [Option] guarded by a mutex, for optional values shared between goroutines. Use
+// [Mutex.Get] and [Mutex.Set] for simple reads and writes, and [Mutex.Do] for compound operations
+// (such as check-then-set) that must happen while holding the lock.
+//
+// The zero value of Mutex is an unlocked, empty option. A Mutex must not be copied after first
+// use.
+type Mutex[T any] struct {
+	mutex  sync.Mutex
+	option Option[T]
+}
+
+// Get returns a copy of the current option.
+func (mutex *Mutex[T]) Get() Option[T] {
+	mutex.mutex.Lock()
+	defer mutex.mutex.Unlock()
+
+	return mutex.option.Normalized()
+}
+
+// Set replaces the current option with the given one.
+func (mutex *Mutex[T]) Set(option Option[T]) {
+	mutex.mutex.Lock()
+	defer mutex.mutex.Unlock()
+
+	mutex.option = option.Normalized()
+}
+
+// Do calls the given function with a pointer to the guarded option, while holding the lock. The
+// function may read and modify the option, but it must not keep the pointer after returning, and
+// it must not call other methods on the Mutex (which would deadlock).
+func (mutex *Mutex[T]) Do(fn func(option *Option[T])) {
+	mutex.mutex.Lock()
+	defer mutex.mutex.Unlock()
+
+	fn(&mutex.option)
+}
+
+// RecvTimeout waits to receive a value from the given channel, for at most the given timeout. If a
+// value is received, it returns an option containing it. If the timeout expires first, or the
+// channel is closed, an empty option is returned.
+func RecvTimeout[T any](channel <-chan T, timeout time.Duration) Option[T] {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case value, ok := <-channel:
+		if ok {
+			return Option[T]{hasValue: true, Value: value}
+		} else {
+			return Option[T]{hasValue: false}
+		}
+	case <-timer.C:
+		return Option[T]{hasValue: false}
+	}
+}
+
+// Pool is implemented by resource pools with a non-blocking acquire method, which lets you use
+// them with [TryAcquire].
+type Pool[T any] interface {
+	TryGet() (resource T, ok bool)
+}
+
+// TryAcquire tries to get a resource from the given pool without blocking, and returns an option
+// containing the resource. If the pool is exhausted, an empty option is returned.
+func TryAcquire[T any](pool Pool[T]) Option[T] {
+	if resource, ok := pool.TryGet(); ok {
+		return Option[T]{hasValue: true, Value: resource}
+	} else {
+		return Option[T]{hasValue: false}
+	}
+}
+
+// StoreInto atomically stores the option in the given [atomic.Pointer]. If the option has a value,
+// a pointer to a copy of the value is stored. If the option is empty, nil is stored. Readers can
+// then use [atomic.Pointer.Load] with [FromPointer] to get the option back.
+func (option Option[T]) StoreInto(target *atomic.Pointer[T]) {
+	target.Store(option.ToPointer())
+}
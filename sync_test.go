@@ -0,0 +1,151 @@
+package opt_test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"hermannm.dev/opt"
+)
+
+func TestMutexGetAndSet(t *testing.T) {
+	var mutex opt.Mutex[string]
+
+	if option := mutex.Get(); !option.IsEmpty() {
+		t.Errorf("Get() = %v; want <empty>", option)
+	}
+
+	mutex.Set(opt.Value("test"))
+
+	if option := mutex.Get(); !option.HasValue() || option.Value != "test" {
+		t.Errorf("Get() = %v; want 'test'", option)
+	}
+}
+
+func TestMutexDoConcurrent(t *testing.T) {
+	var mutex opt.Mutex[int]
+
+	const goroutines = 100
+	var wg sync.WaitGroup
+	for range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			mutex.Do(func(option *opt.Option[int]) {
+				if option.IsEmpty() {
+					option.Put(1)
+				} else {
+					option.Put(option.Value + 1)
+				}
+			})
+		}()
+	}
+	wg.Wait()
+
+	if option := mutex.Get(); !option.HasValue() || option.Value != goroutines {
+		t.Errorf("Get() = %v; want %d", option, goroutines)
+	}
+}
+
+func TestRecvTimeoutReady(t *testing.T) {
+	channel := make(chan string, 1)
+	channel <- "test"
+
+	option := opt.RecvTimeout(channel, time.Second)
+	if !option.HasValue() || option.Value != "test" {
+		t.Errorf("RecvTimeout() = %v; want 'test'", option)
+	}
+}
+
+func TestRecvTimeoutExpired(t *testing.T) {
+	channel := make(chan string)
+
+	option := opt.RecvTimeout(channel, time.Millisecond)
+	if !option.IsEmpty() {
+		t.Errorf("RecvTimeout() = %v; want <empty>", option)
+	}
+}
+
+func TestRecvTimeoutClosed(t *testing.T) {
+	channel := make(chan string)
+	close(channel)
+
+	option := opt.RecvTimeout(channel, time.Second)
+	if !option.IsEmpty() {
+		t.Errorf("RecvTimeout() = %v; want <empty>", option)
+	}
+}
+
+type fakePool struct {
+	resources []string
+}
+
+func (pool *fakePool) TryGet() (string, bool) {
+	if len(pool.resources) == 0 {
+		return "", false
+	}
+
+	resource := pool.resources[0]
+	pool.resources = pool.resources[1:]
+	return resource, true
+}
+
+func TestTryAcquire(t *testing.T) {
+	pool := fakePool{resources: []string{"connection"}}
+
+	if option := opt.TryAcquire(&pool); !option.HasValue() || option.Value != "connection" {
+		t.Errorf("TryAcquire() = %v; want 'connection'", option)
+	}
+}
+
+func TestTryAcquireExhausted(t *testing.T) {
+	pool := fakePool{}
+
+	if option := opt.TryAcquire(&pool); !option.IsEmpty() {
+		t.Errorf("TryAcquire() = %v; want <empty>", option)
+	}
+}
+
+func TestStoreIntoValue(t *testing.T) {
+	var target atomic.Pointer[string]
+	opt.Value("test").StoreInto(&target)
+
+	loaded := target.Load()
+	if loaded == nil || *loaded != "test" {
+		t.Errorf("Load() = %v; want pointer to 'test'", loaded)
+	}
+}
+
+func TestStoreIntoEmpty(t *testing.T) {
+	var target atomic.Pointer[string]
+	opt.Value("test").StoreInto(&target)
+	opt.Empty[string]().StoreInto(&target)
+
+	if loaded := target.Load(); loaded != nil {
+		t.Errorf("Load() = %v; want nil", loaded)
+	}
+}
+
+func TestStoreIntoConcurrentReader(t *testing.T) {
+	var target atomic.Pointer[int]
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range 1000 {
+			if option := opt.FromPointer(target.Load()); option.HasValue() && option.Value < 0 {
+				t.Errorf("loaded invalid value %d", option.Value)
+			}
+		}
+	}()
+
+	for i := range 1000 {
+		if i%2 == 0 {
+			opt.Value(i).StoreInto(&target)
+		} else {
+			opt.Empty[int]().StoreInto(&target)
+		}
+	}
+	<-done
+}
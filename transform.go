@@ -0,0 +1,76 @@
+package opt
+
+// Map calls the given function with the option's value, and returns an option containing the
+// result. If the option is empty, an empty option is returned, and the function is not called.
+//
+// This is a function rather than a method on [Option], since Go methods cannot have their own type
+// parameters.
+func Map[T any, U any](option Option[T], fn func(value T) U) Option[U] {
+	if option.hasValue {
+		return Option[U]{hasValue: true, Value: fn(option.Value)}
+	} else {
+		return Option[U]{hasValue: false}
+	}
+}
+
+// FlatMap calls the given function with the option's value, and returns the option that it
+// returns. If the option is empty, an empty option is returned, and the function is not called.
+func FlatMap[T any, U any](option Option[T], fn func(value T) Option[U]) Option[U] {
+	if option.hasValue {
+		return fn(option.Value).Normalized()
+	} else {
+		return Option[U]{hasValue: false}
+	}
+}
+
+// MapOr calls the given function with the option's value, and returns the result. If the option is
+// empty, the given fallback is returned, and the function is not called.
+func MapOr[T any, U any](option Option[T], fallback U, fn func(value T) U) U {
+	if option.hasValue {
+		return fn(option.Value)
+	} else {
+		return fallback
+	}
+}
+
+// Flatten collapses a nested option into a single option, which has a value only if both the outer
+// and the inner option have values.
+func Flatten[T any](option Option[Option[T]]) Option[T] {
+	if option.hasValue {
+		return option.Value.Normalized()
+	} else {
+		return Option[T]{hasValue: false}
+	}
+}
+
+// Match calls onValue with the option's value if it has one, or else calls onEmpty, and returns
+// the result. This lets you handle both cases in a single expression:
+//
+//	greeting := opt.Match(
+//		name,
+//		func(name string) string { return "Hello, " + name },
+//		func() string { return "Hello, stranger" },
+//	)
+func Match[T any, R any](option Option[T], onValue func(value T) R, onEmpty func() R) R {
+	if option.hasValue {
+		return onValue(option.Value)
+	} else {
+		return onEmpty()
+	}
+}
+
+// Build3 calls build with the values of the three given options, and returns an option containing
+// the result, if all three options have values. If any of them is empty, an empty option is
+// returned, and build is not called.
+func Build3[A any, B any, C any, R any](
+	a Option[A],
+	b Option[B],
+	c Option[C],
+	build func(a A, b B, c C) R,
+) Option[R] {
+	if a.hasValue && b.hasValue && c.hasValue {
+		return Option[R]{hasValue: true, Value: build(a.Value, b.Value, c.Value)}
+	} else {
+		return Option[R]{hasValue: false}
+	}
+}
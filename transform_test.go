@@ -0,0 +1,126 @@
+package opt_test
+
+import (
+	"strconv"
+	"testing"
+
+	"hermannm.dev/opt"
+)
+
+func TestMapValue(t *testing.T) {
+	option := opt.Map(opt.Value(5), strconv.Itoa)
+
+	if !option.HasValue() || option.Value != "5" {
+		t.Errorf("Map() = %v; want '5'", option)
+	}
+}
+
+func TestMapEmpty(t *testing.T) {
+	option := opt.Map(opt.Empty[int](), func(value int) string {
+		t.Error("function called on empty option")
+		return strconv.Itoa(value)
+	})
+
+	if !option.IsEmpty() {
+		t.Errorf("Map() = %v; want <empty>", option)
+	}
+}
+
+func parsePositive(value string) opt.Option[int] {
+	number, err := strconv.Atoi(value)
+	if err != nil || number <= 0 {
+		return opt.Empty[int]()
+	}
+	return opt.Value(number)
+}
+
+func TestFlatMap(t *testing.T) {
+	option := opt.FlatMap(opt.Value("5"), parsePositive)
+	if !option.HasValue() || option.Value != 5 {
+		t.Errorf("FlatMap() = %v; want 5", option)
+	}
+	if option := opt.FlatMap(opt.Value("-5"), parsePositive); !option.IsEmpty() {
+		t.Errorf("FlatMap() = %v; want <empty>", option)
+	}
+	if option := opt.FlatMap(opt.Empty[string](), parsePositive); !option.IsEmpty() {
+		t.Errorf("FlatMap() = %v; want <empty>", option)
+	}
+}
+
+func TestMapOr(t *testing.T) {
+	if value := opt.MapOr(opt.Value(5), "none", strconv.Itoa); value != "5" {
+		t.Errorf("MapOr() = %s; want '5'", value)
+	}
+	if value := opt.MapOr(opt.Empty[int](), "none", strconv.Itoa); value != "none" {
+		t.Errorf("MapOr() = %s; want 'none'", value)
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	if option := opt.Flatten(opt.Value(opt.Value(1))); !option.HasValue() || option.Value != 1 {
+		t.Errorf("Flatten(Value(Value(1))) = %v; want 1", option)
+	}
+	if option := opt.Flatten(opt.Value(opt.Empty[int]())); !option.IsEmpty() {
+		t.Errorf("Flatten(Value(Empty())) = %v; want <empty>", option)
+	}
+	if option := opt.Flatten(opt.Empty[opt.Option[int]]()); !option.IsEmpty() {
+		t.Errorf("Flatten(Empty()) = %v; want <empty>", option)
+	}
+}
+
+func greet(name opt.Option[string]) string {
+	return opt.Match(
+		name,
+		func(name string) string { return "Hello, " + name },
+		func() string { return "Hello, stranger" },
+	)
+}
+
+func TestMatchValue(t *testing.T) {
+	if greeting := greet(opt.Value("hermannm")); greeting != "Hello, hermannm" {
+		t.Errorf("Match() = %s; want 'Hello, hermannm'", greeting)
+	}
+}
+
+func TestMatchEmpty(t *testing.T) {
+	if greeting := greet(opt.Empty[string]()); greeting != "Hello, stranger" {
+		t.Errorf("Match() = %s; want 'Hello, stranger'", greeting)
+	}
+}
+
+type address struct {
+	street string
+	number int
+	city   string
+}
+
+func newAddress(street string, number int, city string) address {
+	return address{street: street, number: number, city: city}
+}
+
+func TestBuild3AllPresent(t *testing.T) {
+	option := opt.Build3(opt.Value("Main St"), opt.Value(1), opt.Value("Oslo"), newAddress)
+
+	expected := address{street: "Main St", number: 1, city: "Oslo"}
+	if !option.HasValue() || option.Value != expected {
+		t.Errorf("Build3() = %v; want %v", option, expected)
+	}
+}
+
+func TestBuild3SingleMissing(t *testing.T) {
+	failIfCalled := func(street string, number int, city string) address {
+		t.Error("build called with an empty input")
+		return address{}
+	}
+
+	street, number, city := opt.Value("Main St"), opt.Value(1), opt.Value("Oslo")
+	if option := opt.Build3(opt.Empty[string](), number, city, failIfCalled); !option.IsEmpty() {
+		t.Errorf("Build3() with empty a = %v; want <empty>", option)
+	}
+	if option := opt.Build3(street, opt.Empty[int](), city, failIfCalled); !option.IsEmpty() {
+		t.Errorf("Build3() with empty b = %v; want <empty>", option)
+	}
+	if option := opt.Build3(street, number, opt.Empty[string](), failIfCalled); !option.IsEmpty() {
+		t.Errorf("Build3() with empty c = %v; want <empty>", option)
+	}
+}
@@ -0,0 +1,16 @@
+//go:build go1.24
+
+package opt
+
+import (
+	"weak"
+)
+
+// FromWeak creates an [Option] with the value pointed to by the given weak pointer, dereferencing
+// it (like [FromPointer]). If the referent has been garbage collected (or the weak pointer was
+// created from nil), an empty option is returned.
+//
+// This function requires Go 1.24 or later, where the weak package was added.
+func FromWeak[T any](pointer weak.Pointer[T]) Option[T] {
+	return FromPointer(pointer.Value())
+}
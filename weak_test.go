@@ -0,0 +1,50 @@
+//go:build go1.24
+
+package opt_test
+
+import (
+	"runtime"
+	"testing"
+	"weak"
+
+	"hermannm.dev/opt"
+)
+
+func TestFromWeakLive(t *testing.T) {
+	value := new(string)
+	*value = "test"
+	pointer := weak.Make(value)
+
+	option := opt.FromWeak(pointer)
+	if !option.HasValue() || option.Value != "test" {
+		t.Errorf("FromWeak() = %v; want 'test'", option)
+	}
+
+	runtime.KeepAlive(value)
+}
+
+func TestFromWeakCollected(t *testing.T) {
+	pointer := makeUnreferencedWeakPointer()
+
+	// The garbage collector makes no guarantee that the referent is collected on the first cycle,
+	// so we try a few times, and skip the test if it still isn't collected.
+	for range 5 {
+		runtime.GC()
+		if pointer.Value() == nil {
+			break
+		}
+	}
+	if pointer.Value() != nil {
+		t.Skip("referent was not garbage collected")
+	}
+
+	option := opt.FromWeak(pointer)
+	if !option.IsEmpty() {
+		t.Errorf("FromWeak() = %v; want <empty>", option)
+	}
+}
+
+//go:noinline
+func makeUnreferencedWeakPointer() weak.Pointer[[64]byte] {
+	return weak.Make(new([64]byte))
+}